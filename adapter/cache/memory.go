@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+type Store interface {
+	Get(key string) (any, bool)
+	Set(key string, value any, ttl time.Duration)
+	Delete(key string)
+	DeletePrefix(prefix string)
+}
+
+type entry struct {
+	value     any
+	expiresAt time.Time
+}
+
+type Memory struct {
+	mu         sync.RWMutex
+	entries    map[string]entry
+	maxEntries int
+}
+
+func NewMemory(maxEntries int) *Memory {
+	return &Memory{
+		entries:    map[string]entry{},
+		maxEntries: maxEntries,
+	}
+}
+
+func (memory *Memory) Get(key string) (any, bool) {
+	memory.mu.RLock()
+	defer memory.mu.RUnlock()
+
+	entry, ok := memory.entries[key]
+	if !ok || (!entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt)) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (memory *Memory) Set(key string, value any, ttl time.Duration) {
+	memory.mu.Lock()
+	defer memory.mu.Unlock()
+
+	if _, exists := memory.entries[key]; !exists && memory.maxEntries > 0 && len(memory.entries) >= memory.maxEntries {
+		memory.evict()
+	}
+
+	expiresAt := time.Time{}
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+	memory.entries[key] = entry{value: value, expiresAt: expiresAt}
+}
+
+func (memory *Memory) Delete(key string) {
+	memory.mu.Lock()
+	defer memory.mu.Unlock()
+
+	delete(memory.entries, key)
+}
+
+func (memory *Memory) DeletePrefix(prefix string) {
+	memory.mu.Lock()
+	defer memory.mu.Unlock()
+
+	for key := range memory.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(memory.entries, key)
+		}
+	}
+}
+
+func (memory *Memory) evict() {
+	now := time.Now()
+	for key, entry := range memory.entries {
+		if !entry.expiresAt.IsZero() && now.After(entry.expiresAt) {
+			delete(memory.entries, key)
+		}
+	}
+	if len(memory.entries) < memory.maxEntries {
+		return
+	}
+	for key := range memory.entries {
+		delete(memory.entries, key)
+		return
+	}
+}
@@ -0,0 +1,20 @@
+package productcache
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) AdjustPrices(ctx context.Context, adjustPriceRequest *dto.AdjustPriceRequest) (int64, error) {
+	affected, err := repository.ProductRepository.AdjustPrices(ctx, adjustPriceRequest)
+	if err != nil {
+		return 0, err
+	}
+
+	if affected > 0 {
+		repository.invalidateProducts()
+		repository.invalidateListings()
+	}
+	return affected, nil
+}
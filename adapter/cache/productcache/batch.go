@@ -0,0 +1,24 @@
+package productcache
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) Batch(ctx context.Context, batchRequest *dto.BatchRequest) ([]domain.BatchResult, error) {
+	results, err := repository.ProductRepository.Batch(ctx, batchRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.Status == domain.BatchStatusOK {
+			repository.invalidateProducts()
+			repository.invalidateListings()
+			break
+		}
+	}
+	return results, nil
+}
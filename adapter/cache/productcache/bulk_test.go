@@ -0,0 +1,84 @@
+package productcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/cache"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// bulkDatabase accepts every bulk create and batch operation.
+type bulkDatabase struct {
+	domain.ProductRepository
+}
+
+func (bulkDatabase) CreateManyCopy(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error) {
+	return int64(len(productRequests)), nil
+}
+
+func (bulkDatabase) Batch(ctx context.Context, batchRequest *dto.BatchRequest) ([]domain.BatchResult, error) {
+	results := make([]domain.BatchResult, len(batchRequest.Operations))
+	for i, operation := range batchRequest.Operations {
+		results[i] = domain.BatchResult{Index: i, Op: operation.Op, ID: int32(i + 1), Status: domain.BatchStatusOK}
+	}
+	return results, nil
+}
+
+// deleteCountingStore counts the deletes made on a memory store.
+type deleteCountingStore struct {
+	*cache.Memory
+	deletes int
+}
+
+func (store *deleteCountingStore) Delete(key string) {
+	store.deletes++
+	store.Memory.Delete(key)
+}
+
+func (store *deleteCountingStore) DeletePrefix(prefix string) {
+	store.deletes++
+	store.Memory.DeletePrefix(prefix)
+}
+
+func TestBulkWritesInvalidateOnce(t *testing.T) {
+	const products = 500
+	productRequests := make([]*dto.CreateProductRequest, products)
+	operations := make([]dto.BatchOperation, products)
+	for i := range productRequests {
+		productRequests[i] = &dto.CreateProductRequest{Name: "Chair", Price: 10}
+		operations[i] = dto.BatchOperation{Op: dto.BatchOpCreate, Product: productRequests[i]}
+	}
+	store := &deleteCountingStore{Memory: cache.NewMemory(0)}
+	repository := New(bulkDatabase{}, store).(*repository)
+	store.Set(repository.productKey(1), &domain.Product{ID: 1, UpdatedAt: time.Now()}, 0)
+
+	if _, err := repository.CreateManyCopy(context.Background(), productRequests); err != nil {
+		t.Fatal(err)
+	}
+	if got := repository.generations.listings.Load(); got != 1 {
+		t.Errorf("listing invalidations after CreateManyCopy = %d, want 1", got)
+	}
+	if got := repository.generations.products.Load(); got != 0 {
+		t.Errorf("product invalidations after CreateManyCopy = %d, want 0", got)
+	}
+
+	if _, err := repository.Batch(context.Background(), &dto.BatchRequest{Operations: operations}); err != nil {
+		t.Fatal(err)
+	}
+	if got := repository.generations.listings.Load(); got != 2 {
+		t.Errorf("listing invalidations after Batch = %d, want 2", got)
+	}
+	if got := repository.generations.products.Load(); got != 1 {
+		t.Errorf("product invalidations after Batch = %d, want 1", got)
+	}
+	if _, ok := store.Get(repository.productKey(1)); ok {
+		t.Error("product cached before the batch is still served")
+	}
+
+	if store.deletes != 0 {
+		t.Errorf("store deletes = %d, want 0: bulk writes bump a generation instead", store.deletes)
+	}
+}
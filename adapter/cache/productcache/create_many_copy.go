@@ -0,0 +1,19 @@
+package productcache
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) CreateManyCopy(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error) {
+	created, err := repository.ProductRepository.CreateManyCopy(ctx, productRequests)
+	if err != nil {
+		return 0, err
+	}
+
+	if created > 0 {
+		repository.invalidateListings()
+	}
+	return created, nil
+}
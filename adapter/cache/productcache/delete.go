@@ -0,0 +1,13 @@
+package productcache
+
+import "context"
+
+func (repository repository) Delete(ctx context.Context, id int32) error {
+	if err := repository.ProductRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	repository.store.Delete(repository.productKey(id))
+	repository.invalidateListings()
+	return nil
+}
@@ -0,0 +1,23 @@
+package productcache
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (repository repository) DeleteMany(ctx context.Context, ids []int32) ([]domain.DeleteResult, error) {
+	results, err := repository.ProductRepository.DeleteMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.Status == domain.DeleteStatusDeleted {
+			repository.invalidateProducts()
+			repository.invalidateListings()
+			break
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,49 @@
+package productcache
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	key, err := repository.fetchKey(pagination)
+	if err != nil {
+		return repository.ProductRepository.Fetch(ctx, pagination)
+	}
+
+	products, err := repository.ProductRepository.Fetch(ctx, pagination)
+	if err == nil {
+		repository.store.Set(key, copyPagination(products), 0)
+		return products, nil
+	}
+	if !postgres.IsTransient(err) {
+		return nil, err
+	}
+
+	cached, ok := repository.store.Get(key)
+	if !ok {
+		return nil, err
+	}
+	stale := copyPagination(cached.(*domain.Pagination[[]domain.Product]))
+	stale.Stale = true
+	return stale, nil
+}
+
+func (repository repository) fetchKey(pagination *dto.PaginationRequestParams) (string, error) {
+	key, err := json.Marshal(pagination)
+	if err != nil {
+		return "", err
+	}
+	return fetchKeyPrefix + strconv.FormatInt(repository.generations.listings.Load(), 10) + ":" + string(key), nil
+}
+
+func copyPagination(products *domain.Pagination[[]domain.Product]) *domain.Pagination[[]domain.Product] {
+	copied := *products
+	copied.Items = append([]domain.Product(nil), products.Items...)
+	return &copied
+}
@@ -0,0 +1,36 @@
+package productcache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/cache"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestFetchServesStaleWhileTheDatabaseIsDown(t *testing.T) {
+	database := &database{product: domain.Product{ID: 7, Name: "Lamp", Price: 20}}
+	repository := New(database, cache.NewMemory(10))
+	ctx := context.Background()
+	firstPage := dto.DefaultPaginationRequestParams()
+	if _, err := repository.Fetch(ctx, firstPage); err != nil {
+		t.Fatal(err)
+	}
+
+	database.err = errDatabaseDown
+	products, err := repository.Fetch(ctx, firstPage)
+	if err != nil {
+		t.Fatalf("Fetch() of a cached page error = %v", err)
+	}
+	if !products.Stale || products.Total != 1 || len(products.Items) != 1 || products.Items[0].ID != 7 {
+		t.Errorf("Fetch() of a cached page = %+v, want the stale cached page", products)
+	}
+
+	secondPage := &dto.PaginationRequestParams{Page: 2, ItemsPerPage: firstPage.ItemsPerPage}
+	secondPage.Normalize()
+	if _, err := repository.Fetch(ctx, secondPage); !errors.Is(err, errDatabaseDown) {
+		t.Errorf("Fetch() of an uncached page error = %v, want %v", err, errDatabaseDown)
+	}
+}
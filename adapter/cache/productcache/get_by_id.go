@@ -0,0 +1,30 @@
+package productcache
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (repository repository) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	key := repository.productKey(id)
+
+	product, err := repository.ProductRepository.GetByID(ctx, id)
+	if err == nil {
+		copied := *product
+		repository.store.Set(key, &copied, 0)
+		return product, nil
+	}
+	if !postgres.IsTransient(err) {
+		return nil, err
+	}
+
+	cached, ok := repository.store.Get(key)
+	if !ok {
+		return nil, err
+	}
+	stale := *cached.(*domain.Product)
+	stale.Stale = true
+	return &stale, nil
+}
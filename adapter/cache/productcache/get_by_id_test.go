@@ -0,0 +1,49 @@
+package productcache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/cache"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func TestGetByIDServesStaleWhileTheDatabaseIsDown(t *testing.T) {
+	tests := []struct {
+		name      string
+		warm      bool
+		err       error
+		wantErr   error
+		wantStale bool
+	}{
+		{name: "warm cache", warm: true, err: errDatabaseDown, wantStale: true},
+		{name: "cold cache", err: errDatabaseDown, wantErr: errDatabaseDown},
+		{name: "not a database failure", warm: true, err: domain.ErrProductNotFound, wantErr: domain.ErrProductNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			database := &database{product: domain.Product{ID: 7, Name: "Lamp", Price: 20}}
+			repository := New(database, cache.NewMemory(10))
+			ctx := context.Background()
+			if test.warm {
+				if _, err := repository.GetByID(ctx, 7); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			database.err = test.err
+			product, err := repository.GetByID(ctx, 7)
+
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("GetByID() error = %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if product.ID != 7 || product.Name != "Lamp" || product.Stale != test.wantStale {
+				t.Errorf("GetByID() = %+v, want the cached lamp with Stale = %v", product, test.wantStale)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package productcache
+
+import (
+	"strconv"
+	"sync/atomic"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/cache"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+const (
+	productKeyPrefix = "product:id:"
+	fetchKeyPrefix   = "product:fetch:"
+)
+
+type repository struct {
+	domain.ProductRepository
+	store       cache.Store
+	generations *generations
+}
+
+// generations version the cache keys. Bumping one orphans every key built
+// with the previous value at once, so bulk writes invalidate in O(1); the
+// orphans are evicted as the store fills up.
+type generations struct {
+	products atomic.Int64
+	listings atomic.Int64
+}
+
+func New(productRepository domain.ProductRepository, store cache.Store) domain.ProductRepository {
+	return &repository{
+		ProductRepository: productRepository,
+		store:             store,
+		generations:       &generations{},
+	}
+}
+
+func (repository repository) productKey(id int32) string {
+	return productKeyPrefix + strconv.FormatInt(repository.generations.products.Load(), 10) + ":" + strconv.Itoa(int(id))
+}
+
+func (repository repository) invalidateProducts() {
+	repository.generations.products.Add(1)
+}
+
+func (repository repository) invalidateListings() {
+	repository.generations.listings.Add(1)
+}
@@ -0,0 +1,38 @@
+package productcache
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// errDatabaseDown is how a pool reports a server that went away mid-query.
+var errDatabaseDown = &pgconn.PgError{Code: pgerrcode.AdminShutdown}
+
+// database serves product by id and as a one-item listing until err is set,
+// then fails every read with err. Any other call panics on the nil embedded
+// interface.
+type database struct {
+	domain.ProductRepository
+	product domain.Product
+	err     error
+}
+
+func (database *database) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	if database.err != nil {
+		return nil, database.err
+	}
+	product := database.product
+	return &product, nil
+}
+
+func (database *database) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	if database.err != nil {
+		return nil, database.err
+	}
+	return &domain.Pagination[[]domain.Product]{Items: []domain.Product{database.product}, Total: 1}, nil
+}
@@ -0,0 +1,21 @@
+package productcache
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) UpdateIfUnmodified(ctx context.Context, id int32, updatedAt time.Time, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	product, err := repository.ProductRepository.UpdateIfUnmodified(ctx, id, updatedAt, productRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	copied := *product
+	repository.store.Set(repository.productKey(id), &copied, 0)
+	repository.invalidateListings()
+	return product, nil
+}
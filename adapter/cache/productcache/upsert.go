@@ -0,0 +1,20 @@
+package productcache
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) Upsert(ctx context.Context, id int32, productRequest *dto.CreateProductRequest) (*domain.Product, bool, error) {
+	product, created, err := repository.ProductRepository.Upsert(ctx, id, productRequest)
+	if err != nil {
+		return nil, false, err
+	}
+
+	copied := *product
+	repository.store.Set(repository.productKey(id), &copied, 0)
+	repository.invalidateListings()
+	return product, created, nil
+}
@@ -0,0 +1,51 @@
+package productcoalesce
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	key, err := json.Marshal(pagination)
+	if err != nil {
+		return repository.ProductRepository.Fetch(ctx, pagination)
+	}
+
+	// The shared call must not die with whichever caller started it, so it
+	// runs detached and each caller only stops waiting on its own context.
+	// It still keeps the starter's deadline, so an expired request budget
+	// cancels the query instead of leaving it running.
+	result := repository.fetches.DoChan(string(key), func() (interface{}, error) {
+		shared, cancel := detach(ctx)
+		defer cancel()
+		return repository.ProductRepository.Fetch(shared, pagination)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case fetched := <-result:
+		if fetched.Err != nil {
+			return nil, fetched.Err
+		}
+		return copyPagination(fetched.Val.(*domain.Pagination[[]domain.Product])), nil
+	}
+}
+
+func detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+	return detached, func() {}
+}
+
+// copyPagination gives each caller its own items, since the use case
+// fills in effective prices in place.
+func copyPagination(products *domain.Pagination[[]domain.Product]) *domain.Pagination[[]domain.Product] {
+	copied := *products
+	copied.Items = append([]domain.Product(nil), products.Items...)
+	return &copied
+}
@@ -0,0 +1,66 @@
+package productcoalesce
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// slowRepository counts its Fetches and holds each of them until release is
+// closed, so concurrent callers pile up behind the first one.
+type slowRepository struct {
+	domain.ProductRepository
+	fetches atomic.Int32
+	release chan struct{}
+}
+
+func (repository *slowRepository) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	repository.fetches.Add(1)
+	<-repository.release
+	return &domain.Pagination[[]domain.Product]{Items: []domain.Product{{ID: 1, Name: "Chair"}}, Total: 1}, nil
+}
+
+func TestFetchSharesConcurrentIdenticalQueries(t *testing.T) {
+	const callers = 20
+	slow := &slowRepository{release: make(chan struct{})}
+	repository := New(slow)
+
+	var started, done sync.WaitGroup
+	results := make([]*domain.Pagination[[]domain.Product], callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		started.Add(1)
+		done.Add(1)
+		go func(i int) {
+			defer done.Done()
+			started.Done()
+			results[i], errs[i] = repository.Fetch(context.Background(), &dto.PaginationRequestParams{Search: "chair", Page: 1, ItemsPerPage: 10})
+		}(i)
+	}
+	started.Wait()
+	// Give the callers time to join the in-flight Fetch before it returns.
+	time.Sleep(50 * time.Millisecond)
+	close(slow.release)
+	done.Wait()
+
+	if fetches := slow.fetches.Load(); fetches != 1 {
+		t.Errorf("repository fetches = %d, want 1", fetches)
+	}
+	for i := 0; i < callers; i++ {
+		if errs[i] != nil {
+			t.Fatalf("caller %d: %v", i, errs[i])
+		}
+		if len(results[i].Items) != 1 || results[i].Items[0].Name != "Chair" {
+			t.Fatalf("caller %d items = %+v", i, results[i].Items)
+		}
+	}
+	results[0].Items[0].Price = 99
+	if results[1].Items[0].Price == 99 {
+		t.Error("callers share one items slice")
+	}
+}
@@ -0,0 +1,19 @@
+package productcoalesce
+
+import (
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"golang.org/x/sync/singleflight"
+)
+
+type repository struct {
+	domain.ProductRepository
+	fetches *singleflight.Group
+}
+
+// New shares one repository call between concurrent identical Fetches.
+func New(productRepository domain.ProductRepository) domain.ProductRepository {
+	return &repository{
+		ProductRepository: productRepository,
+		fetches:           &singleflight.Group{},
+	}
+}
@@ -0,0 +1,26 @@
+package features
+
+import (
+	"net/http"
+
+	"github.com/spf13/viper"
+)
+
+// Enabled reports whether the flag features.<name> is set. Unknown flags are
+// disabled.
+func Enabled(name string) bool {
+	return viper.GetBool("features." + name)
+}
+
+// Gate answers 404 instead of calling next while the feature is disabled.
+// The route stays registered so that it is not shadowed by a broader
+// pattern such as /product/{id}.
+func Gate(name string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		if !Enabled(name) {
+			http.NotFound(response, request)
+			return
+		}
+		next.ServeHTTP(response, request)
+	})
+}
@@ -0,0 +1,39 @@
+package features
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestGate(t *testing.T) {
+	ok := http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		response.WriteHeader(http.StatusOK)
+	})
+	tests := []struct {
+		name     string
+		flags    map[string]any
+		expected int
+	}{
+		{"enabled", map[string]any{"features.facets": true}, http.StatusOK},
+		{"disabled", map[string]any{"features.facets": false}, http.StatusNotFound},
+		{"unset", map[string]any{}, http.StatusNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+			for key, value := range test.flags {
+				viper.Set(key, value)
+			}
+
+			response := httptest.NewRecorder()
+			Gate("facets", ok).ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/product/facets", nil))
+			if response.Code != test.expected {
+				t.Errorf("status = %d, want %d", response.Code, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package adminservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (service *Service) DBStats(response http.ResponseWriter, request *http.Request) {
+	stats, err := postgres.GetTableStats(request.Context(), service.db, service.table)
+	if err != nil {
+		respond.Error(response, request, 500, dto.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	respond.JSON(response, request, 200, stats)
+}
+
+func (service *Service) Analyze(response http.ResponseWriter, request *http.Request) {
+	if err := postgres.Analyze(request.Context(), service.db, service.table); err != nil {
+		respond.Error(response, request, 500, dto.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	service.DBStats(response, request)
+}
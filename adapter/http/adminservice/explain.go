@@ -0,0 +1,52 @@
+package adminservice
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres/productrepository"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// Explain returns the plan of the listing query GET /product would run for
+// the same query parameters, as JSON or, with format=text, as Postgres' text
+// plan. Only analyze=true executes the query to report actual timings.
+func (service *Service) Explain(response http.ResponseWriter, request *http.Request) {
+	pagination, err := dto.FromValuePaginationRequestParams(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+	analyze := false
+	if value := request.FormValue("analyze"); value != "" {
+		analyze, err = strconv.ParseBool(value)
+		if err != nil {
+			respond.Error(response, request, 400, dto.ErrorCodeBadRequest, "invalid analyze value: expected true or false")
+			return
+		}
+	}
+	format := request.FormValue("format")
+	if format == "" {
+		format = productrepository.ExplainFormatJSON
+	}
+
+	plan, err := service.explainer.ExplainFetch(request.Context(), pagination, format, analyze)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+			return
+		}
+		respond.Error(response, request, 500, dto.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	if format == productrepository.ExplainFormatText {
+		response.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	} else {
+		response.Header().Set("Content-Type", "application/json")
+	}
+	response.Write(plan)
+}
@@ -0,0 +1,41 @@
+package adminservice
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres/productrepository"
+	"github.com/jackc/pgx/v4"
+)
+
+type Service struct {
+	db        postgres.PoolInterface
+	table     pgx.Identifier
+	explainer productrepository.Explainer
+	mu        sync.Mutex
+	reindex   TaskStatus
+	// tasks bounds the maintenance started in the background, so Close can
+	// cancel it on shutdown and wait for it to stop.
+	tasks       context.Context
+	cancelTasks context.CancelFunc
+	running     sync.WaitGroup
+}
+
+func New(db postgres.PoolInterface, table pgx.Identifier, explainer productrepository.Explainer) *Service {
+	tasks, cancelTasks := context.WithCancel(context.Background())
+	return &Service{
+		db:          db,
+		table:       table,
+		explainer:   explainer,
+		tasks:       tasks,
+		cancelTasks: cancelTasks,
+	}
+}
+
+// Close cancels any running background maintenance and waits for it to
+// return.
+func (service *Service) Close() {
+	service.cancelTasks()
+	service.running.Wait()
+}
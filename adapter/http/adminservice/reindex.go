@@ -0,0 +1,59 @@
+package adminservice
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+)
+
+type TaskStatus struct {
+	Running    bool       `json:"running"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+func (service *Service) Reindex(response http.ResponseWriter, request *http.Request) {
+	service.mu.Lock()
+	if service.reindex.Running {
+		status := service.reindex
+		service.mu.Unlock()
+		respond.JSON(response, request, 409, status)
+		return
+	}
+	startedAt := time.Now()
+	service.reindex = TaskStatus{Running: true, StartedAt: &startedAt}
+	status := service.reindex
+	service.running.Add(1)
+	service.mu.Unlock()
+
+	go service.runReindex()
+
+	respond.JSON(response, request, 202, status)
+}
+
+func (service *Service) ReindexStatus(response http.ResponseWriter, request *http.Request) {
+	service.mu.Lock()
+	status := service.reindex
+	service.mu.Unlock()
+
+	respond.JSON(response, request, 200, status)
+}
+
+func (service *Service) runReindex() {
+	defer service.running.Done()
+	err := postgres.Reindex(service.tasks, service.db, service.table)
+
+	finishedAt := time.Now()
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.reindex.Running = false
+	service.reindex.FinishedAt = &finishedAt
+	if err != nil {
+		slog.Error("Unable to reindex products", "error", err)
+		service.reindex.Error = err.Error()
+	}
+}
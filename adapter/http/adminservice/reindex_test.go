@@ -0,0 +1,54 @@
+package adminservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// blockingPool records the statements it executes and blocks each of them
+// until its context is cancelled.
+type blockingPool struct {
+	postgres.PoolInterface
+	started chan string
+}
+
+func (pool *blockingPool) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	pool.started <- sql
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestReindex(t *testing.T) {
+	pool := &blockingPool{started: make(chan string, 1)}
+	service := New(pool, pgx.Identifier{"public", "product"}, nil)
+
+	response := httptest.NewRecorder()
+	service.Reindex(response, httptest.NewRequest(http.MethodPost, "/admin/reindex", nil))
+	if response.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d", response.Code, http.StatusAccepted)
+	}
+	if sql := <-pool.started; sql != `REINDEX TABLE CONCURRENTLY "public"."product"` {
+		t.Errorf("sql = %q", sql)
+	}
+
+	response = httptest.NewRecorder()
+	service.Reindex(response, httptest.NewRequest(http.MethodPost, "/admin/reindex", nil))
+	if response.Code != http.StatusConflict {
+		t.Errorf("status while running = %d, want %d", response.Code, http.StatusConflict)
+	}
+
+	service.Close()
+
+	response = httptest.NewRecorder()
+	service.ReindexStatus(response, httptest.NewRequest(http.MethodGet, "/admin/reindex", nil))
+	if body := response.Body.String(); !strings.Contains(body, `"running":false`) || !strings.Contains(body, context.Canceled.Error()) {
+		t.Errorf("status after Close = %s", body)
+	}
+}
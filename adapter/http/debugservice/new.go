@@ -0,0 +1,14 @@
+package debugservice
+
+import "github.com/gabriwl165/clean-arch-go/adapter/postgres"
+
+type Service struct {
+	poolStats func() map[string]postgres.PoolStats
+}
+
+// New reports poolStats, the statistics of every database pool by name.
+func New(poolStats func() map[string]postgres.PoolStats) *Service {
+	return &Service{
+		poolStats: poolStats,
+	}
+}
@@ -0,0 +1,11 @@
+package debugservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+)
+
+func (service *Service) Pool(response http.ResponseWriter, request *http.Request) {
+	respond.JSON(response, request, 200, service.poolStats())
+}
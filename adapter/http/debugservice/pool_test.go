@@ -0,0 +1,54 @@
+package debugservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+)
+
+func TestPool(t *testing.T) {
+	stats := map[string]postgres.PoolStats{
+		"products": {
+			AcquiredConns:        2,
+			IdleConns:            3,
+			TotalConns:           5,
+			MaxConns:             10,
+			AcquireCount:         120,
+			AcquireDuration:      1500 * time.Millisecond,
+			EmptyAcquireCount:    4,
+			CanceledAcquireCount: 1,
+		},
+	}
+	service := New(func() map[string]postgres.PoolStats { return stats })
+
+	response := httptest.NewRecorder()
+	service.Pool(response, httptest.NewRequest(http.MethodGet, "/debug/pool", nil))
+
+	if response.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", response.Code, http.StatusOK)
+	}
+	body := map[string]map[string]any{}
+	if err := json.Unmarshal(response.Body.Bytes(), &body); err != nil {
+		t.Fatalf("body = %s: %v", response.Body.String(), err)
+	}
+	want := map[string]map[string]any{
+		"products": {
+			"acquiredConns":        float64(2),
+			"idleConns":            float64(3),
+			"totalConns":           float64(5),
+			"maxConns":             float64(10),
+			"acquireCount":         float64(120),
+			"acquireDuration":      float64(1500 * time.Millisecond),
+			"emptyAcquireCount":    float64(4),
+			"canceledAcquireCount": float64(1),
+		},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("body = %v, want %v", body, want)
+	}
+}
@@ -0,0 +1,25 @@
+package healthservice
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type Service struct {
+	db    Pinger
+	ready atomic.Bool
+}
+
+func New(db Pinger) *Service {
+	return &Service{
+		db: db,
+	}
+}
+
+func (service *Service) SetReady(ready bool) {
+	service.ready.Store(ready)
+}
@@ -0,0 +1,31 @@
+package healthservice
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+func (service *Service) Livez(response http.ResponseWriter, request *http.Request) {
+	response.WriteHeader(200)
+	response.Write([]byte("ok"))
+}
+
+func (service *Service) Readyz(response http.ResponseWriter, request *http.Request) {
+	if !service.ready.Load() {
+		response.WriteHeader(503)
+		response.Write([]byte("not ready"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(request.Context(), 2*time.Second)
+	defer cancel()
+	if err := service.db.Ping(ctx); err != nil {
+		response.WriteHeader(503)
+		response.Write([]byte(err.Error()))
+		return
+	}
+
+	response.WriteHeader(200)
+	response.Write([]byte("ok"))
+}
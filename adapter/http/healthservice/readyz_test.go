@@ -0,0 +1,41 @@
+package healthservice
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type pingerFunc func(ctx context.Context) error
+
+func (ping pingerFunc) Ping(ctx context.Context) error {
+	return ping(ctx)
+}
+
+func TestReadyz(t *testing.T) {
+	tests := []struct {
+		name       string
+		ready      bool
+		pingErr    error
+		wantStatus int
+	}{
+		{name: "ready", ready: true, wantStatus: 200},
+		{name: "starting", wantStatus: 503},
+		{name: "database down", ready: true, pingErr: errors.New(`database "analytics": connection refused`), wantStatus: 503},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := New(pingerFunc(func(context.Context) error { return test.pingErr }))
+			service.SetReady(test.ready)
+			response := httptest.NewRecorder()
+
+			service.Readyz(response, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package healthservice
+
+import "context"
+
+// Start runs warmup, which opens the connection pools' minimum connections,
+// and then marks the service ready. A failed warmup holds readiness back
+// only when warmupRequired is set; otherwise the service becomes ready
+// anyway, since /readyz still pings the database on every check, and the
+// error is returned for the caller to log.
+func (service *Service) Start(ctx context.Context, warmup func(context.Context) error, warmupRequired bool) error {
+	err := warmup(ctx)
+	if err != nil && warmupRequired {
+		return err
+	}
+	service.SetReady(true)
+	return err
+}
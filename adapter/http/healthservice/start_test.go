@@ -0,0 +1,49 @@
+package healthservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestStart(t *testing.T) {
+	const minConns = 4
+	tests := []struct {
+		name           string
+		warmupErr      error
+		warmupRequired bool
+		wantReady      bool
+	}{
+		{name: "warmed up", wantReady: true},
+		{name: "required warmup warmed up", warmupRequired: true, wantReady: true},
+		{name: "optional warmup failed", warmupErr: errors.New("connection refused"), wantReady: true},
+		{name: "required warmup failed", warmupErr: errors.New("connection refused"), warmupRequired: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := New(pingerFunc(func(context.Context) error { return nil }))
+			opened := 0
+			warmup := func(context.Context) error {
+				for opened < minConns {
+					if service.ready.Load() {
+						t.Fatalf("ready after %d of %d connections", opened, minConns)
+					}
+					opened++
+				}
+				return test.warmupErr
+			}
+
+			err := service.Start(context.Background(), warmup, test.warmupRequired)
+
+			if !errors.Is(err, test.warmupErr) {
+				t.Errorf("Start() error = %v, want %v", err, test.warmupErr)
+			}
+			if opened != minConns {
+				t.Errorf("opened %d connections, want %d", opened, minConns)
+			}
+			if ready := service.ready.Load(); ready != test.wantReady {
+				t.Errorf("ready = %v, want %v", ready, test.wantReady)
+			}
+		})
+	}
+}
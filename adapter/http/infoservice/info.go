@@ -0,0 +1,27 @@
+package infoservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+)
+
+var (
+	Version   = "dev"
+	Commit    = "dev"
+	BuildTime = "dev"
+)
+
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+}
+
+func Info(response http.ResponseWriter, request *http.Request) {
+	respond.JSON(response, request, 200, BuildInfo{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+	})
+}
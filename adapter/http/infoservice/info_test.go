@@ -0,0 +1,43 @@
+package infoservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		version   string
+		commit    string
+		buildTime string
+	}{
+		{name: "unset", version: "dev", commit: "dev", buildTime: "dev"},
+		{name: "injected", version: "1.2.0", commit: "0ba1b33", buildTime: "2024-05-01T12:00:00Z"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			previous := []string{Version, Commit, BuildTime}
+			t.Cleanup(func() { Version, Commit, BuildTime = previous[0], previous[1], previous[2] })
+			Version, Commit, BuildTime = test.version, test.commit, test.buildTime
+
+			response := httptest.NewRecorder()
+			Info(response, httptest.NewRequest(http.MethodGet, "/info", nil))
+
+			if response.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", response.Code, http.StatusOK)
+			}
+			body := map[string]any{}
+			if err := json.Unmarshal(response.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body = %s: %v", response.Body.String(), err)
+			}
+			want := map[string]any{"version": test.version, "commit": test.commit, "buildTime": test.buildTime}
+			if !reflect.DeepEqual(body, want) {
+				t.Errorf("body = %v, want %v", body, want)
+			}
+		})
+	}
+}
@@ -4,40 +4,192 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/gabriwl165/clean-arch-go/adapter/http/adminservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/debugservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/healthservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/infoservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/middleware"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/adapter/metrics"
 	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/adapter/worker"
+	"github.com/gabriwl165/clean-arch-go/adapter/worker/reconciler"
 	"github.com/gabriwl165/clean-arch-go/di"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
 )
 
-func init() {
+// configure loads config.json from the working directory and installs the
+// logger it describes. It runs from main rather than init so the package's
+// tests do not need a config file.
+func configure() {
 	viper.SetConfigFile(`config.json`)
 	err := viper.ReadInConfig()
 	if err != nil {
 		panic(err)
 	}
+
+	logger, err := logging.New(viper.GetString("log.level"), viper.GetString("log.format"), os.Stderr)
+	if err != nil {
+		panic(err)
+	}
+	slog.SetDefault(logger)
 }
 
 func main() {
-	ctx := context.Background()
-	conn := postgres.GetConnection(ctx)
-	defer conn.Close()
+	configure()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logStartupSummary()
+	// The migrations create the product tables, so they run against the
+	// database the product repository uses.
+	migrationErr := postgres.RunMigrations(ctx, postgres.DatabaseURL(viper.GetString("db.productDatabase")), postgres.MigrationsDir, di.ProductTable())
+	if migrationErr != nil {
+		slog.Error("Unable to run migrations", "error", migrationErr)
+	}
+
+	databases, err := postgres.NewRegistry(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer databases.Close()
 
-	postgres.RunMigrations()
-	productService := di.ConfigProductDI(conn)
+	productConn, err := databases.Get(viper.GetString("db.productDatabase"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var productDB postgres.PoolInterface = productConn
+	if viper.GetBool("debug") {
+		productDB = postgres.CountQueries(productDB)
+	}
+	productUseCase := di.ConfigProductUseCaseDI(productDB)
+	productService := di.ConfigProductDI(productUseCase)
 	router := mux.NewRouter()
-	router.Handle("/product", http.HandlerFunc(productService.Create)).Methods("POST")
-	router.Handle("/product", http.HandlerFunc(productService.Fetch)).Queries(
-		"page", "{page}",
-		"itemsPerPage", "{itemsPerPage}",
-		"descending", "{descending}",
-		"sort", "{sort}",
-		"search", "{search}",
-	).Methods("GET")
+	router.MethodNotAllowedHandler = middleware.MethodNotAllowed(router)
+	router.NotFoundHandler = middleware.NotFound()
+	realIP, err := middleware.RealIP(viper.GetStringSlice("server.trustedProxies"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	router.Use(realIP)
+	router.Use(middleware.RequestLogger())
+	jsonNaming, err := middleware.JSONNaming(viper.GetString("json.naming"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	router.Use(jsonNaming)
+	if viper.GetBool("debug") {
+		router.Use(middleware.QueryCounter())
+	}
+	router.Use(middleware.RouteTimeout(middleware.TimeoutOptions{
+		Default:   viper.GetDuration("server.timeout"),
+		Routes:    routeTimeouts(),
+		MaxClient: viper.GetDuration("server.maxClientTimeout"),
+		Budget:    viper.GetDuration("server.requestBudget"),
+	}))
+	healthService := healthservice.New(databases)
+	router.Handle("/livez", http.HandlerFunc(healthService.Livez)).Methods("GET")
+	router.Handle("/readyz", http.HandlerFunc(healthService.Readyz)).Methods("GET")
+	router.Handle("/info", http.HandlerFunc(infoservice.Info)).Methods("GET")
+	productservice.RegisterRoutes(router, productService)
+
+	v1 := router.PathPrefix("/v1").Subrouter()
+	if sunset := viper.GetString("routes.v1.sunset"); sunset != "" {
+		sunsetTime, err := time.Parse(time.RFC3339, sunset)
+		if err != nil {
+			log.Fatalf("Invalid routes.v1.sunset: %v", err)
+		}
+		v1.Use(middleware.Deprecated(sunsetTime))
+	}
+	productservice.RegisterRoutes(v1, productService)
+
+	adminOnly := middleware.Admin(viper.GetString("admin.token"))
+	admin := router.PathPrefix("/admin").Subrouter()
+	admin.Use(adminOnly)
+	adminService := adminservice.New(productConn, di.ProductTable(), di.ConfigProductExplainerDI(productConn))
+	admin.Handle("/reindex", http.HandlerFunc(adminService.Reindex)).Methods("POST")
+	admin.Handle("/reindex", http.HandlerFunc(adminService.ReindexStatus)).Methods("GET")
+	admin.Handle("/db/stats", http.HandlerFunc(adminService.DBStats)).Methods("GET")
+	admin.Handle("/db/analyze", http.HandlerFunc(adminService.Analyze)).Methods("POST")
+	admin.Handle("/explain", http.HandlerFunc(adminService.Explain)).Methods("GET")
+
+	debugService := debugservice.New(databases.Stats)
+	router.Handle("/debug/pool", middleware.Chain(http.HandlerFunc(debugService.Pool), adminOnly)).Methods("GET")
+	router.Handle("/metrics", promhttp.Handler()).Methods("GET")
+
+	workers := worker.NewManager()
+	workers.Register("poolMetrics", worker.Func(func(ctx context.Context) {
+		metrics.UpdatePoolMetrics(ctx, databases.Stats, viper.GetDuration("metrics.poolInterval"))
+	}))
+	workers.Register("priceScheduler", di.ConfigPriceSchedulerDI(productConn, viper.GetDuration("priceScheduler.interval")))
+	if viper.GetBool("reconciler.enabled") {
+		// A schedule is only overdue once the scheduler has had two chances
+		// to apply it.
+		workers.Register("reconciler", reconciler.New(productConn, di.ProductTable(), viper.GetDuration("reconciler.interval"), 2*viper.GetDuration("priceScheduler.interval")))
+	}
+	if viper.GetBool("cache.staleOnError") && viper.GetBool("cache.warmOnStartup") {
+		workers.Register("cacheWarmer", di.ConfigCacheWarmerDI(productUseCase))
+	}
 
 	port := viper.GetString("server.port")
-	log.Printf("Listening on port: %v", port)
-	http.ListenAndServe(fmt.Sprintf(":%v", port), router)
+	cors := middleware.CORS(middleware.CORSOptions{
+		AllowedOrigins: viper.GetStringSlice("cors.allowedOrigins"),
+		MaxAge:         viper.GetDuration("cors.maxAge"),
+	})
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%v", port),
+		Handler: cors(router),
+	}
+	go func() {
+		slog.Info("Listening", "port", port)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+	workers.Start(ctx)
+	// Readiness waits for the warmup, and stays off when the migrations
+	// failed or a required warmup did.
+	warmupCtx, warmupCancel := context.WithTimeout(ctx, 30*time.Second)
+	if migrationErr == nil {
+		if err := healthService.Start(warmupCtx, databases.Warmup, viper.GetBool("db.warmupRequired")); err != nil {
+			slog.Warn("Unable to warm up connection pools", "error", err, "required", viper.GetBool("db.warmupRequired"))
+		}
+	}
+	warmupCancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	<-signals
+
+	slog.Info("Shutting down")
+	healthService.SetReady(false)
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer shutdownCancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("Unable to shut down server", "error", err)
+	}
+	workers.Stop()
+	adminService.Close()
+}
+
+func routeTimeouts() map[string]time.Duration {
+	timeouts := map[string]time.Duration{}
+	for route, value := range viper.GetStringMapString("server.routeTimeouts") {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid server.routeTimeouts.%v: %v", route, err)
+		}
+		timeouts[strings.ToLower(route)] = timeout
+	}
+	return timeouts
 }
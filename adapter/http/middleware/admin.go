@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func Admin(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			provided := strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")
+			if token == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				respond.Error(response, request, http.StatusUnauthorized, dto.ErrorCodeUnauthorized, http.StatusText(http.StatusUnauthorized))
+				return
+			}
+			next.ServeHTTP(response, request)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// recording returns a middleware that appends name to calls before and after
+// the handler it wraps.
+func recording(name string, calls *[]string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			*calls = append(*calls, name+" before")
+			next.ServeHTTP(response, request)
+			*calls = append(*calls, name+" after")
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	calls := []string{}
+	handler := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		calls = append(calls, "handler")
+	})
+	routeChain := []Middleware{recording("auth", &calls)}
+
+	chained := Chain(Chain(handler, routeChain...), recording("logger", &calls), recording("recover", &calls))
+	chained.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/product", nil))
+
+	want := []string{
+		"logger before",
+		"recover before",
+		"auth before",
+		"handler",
+		"auth after",
+		"recover after",
+		"logger after",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestChainWithoutMiddleware(t *testing.T) {
+	served := false
+	handler := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { served = true })
+
+	Chain(handler).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/product", nil))
+
+	if !served {
+		t.Error("handler not served")
+	}
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type CORSOptions struct {
+	// AllowedOrigins lists the origins allowed to call the API; "*" allows
+	// any origin. CORS is disabled when it is empty.
+	AllowedOrigins []string
+	// MaxAge is how long browsers may cache a preflight response. Zero
+	// omits Access-Control-Max-Age and leaves it to the browser default.
+	MaxAge time.Duration
+}
+
+const corsAllowedMethods = "GET, HEAD, POST, PUT, DELETE"
+
+// CORS answers preflight requests itself, so it must wrap the router rather
+// than be installed with Use: mux only runs middleware for matched routes.
+func CORS(options CORSOptions) func(http.Handler) http.Handler {
+	maxAge := ""
+	if seconds := int(options.MaxAge / time.Second); seconds > 0 {
+		maxAge = strconv.Itoa(seconds)
+	}
+
+	return func(next http.Handler) http.Handler {
+		if len(options.AllowedOrigins) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			origin := request.Header.Get("Origin")
+			if origin == "" || !originAllowed(options.AllowedOrigins, origin) {
+				next.ServeHTTP(response, request)
+				return
+			}
+
+			header := response.Header()
+			header.Set("Access-Control-Allow-Origin", origin)
+			header.Add("Vary", "Origin")
+			if request.Method != http.MethodOptions || request.Header.Get("Access-Control-Request-Method") == "" {
+				next.ServeHTTP(response, request)
+				return
+			}
+
+			header.Set("Access-Control-Allow-Methods", corsAllowedMethods)
+			if requested := request.Header.Get("Access-Control-Request-Headers"); requested != "" {
+				header.Set("Access-Control-Allow-Headers", requested)
+			}
+			if maxAge != "" {
+				header.Set("Access-Control-Max-Age", maxAge)
+			}
+			response.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+func originAllowed(allowed []string, origin string) bool {
+	for _, candidate := range allowed {
+		if candidate == "*" || strings.EqualFold(candidate, origin) {
+			return true
+		}
+	}
+	return false
+}
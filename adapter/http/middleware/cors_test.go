@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCORS(t *testing.T) {
+	tests := []struct {
+		name            string
+		options         CORSOptions
+		method          string
+		origin          string
+		preflight       bool
+		wantStatus      int
+		wantAllowOrigin string
+		wantMaxAge      string
+		wantServed      bool
+	}{
+		{name: "disabled", method: "GET", origin: "https://shop.example", wantStatus: 200, wantServed: true},
+		{name: "allowed origin", options: CORSOptions{AllowedOrigins: []string{"https://shop.example"}}, method: "GET", origin: "https://shop.example", wantStatus: 200, wantAllowOrigin: "https://shop.example", wantServed: true},
+		{name: "other origin", options: CORSOptions{AllowedOrigins: []string{"https://shop.example"}}, method: "GET", origin: "https://evil.example", wantStatus: 200, wantServed: true},
+		{name: "preflight", options: CORSOptions{AllowedOrigins: []string{"*"}, MaxAge: time.Hour}, method: "OPTIONS", origin: "https://shop.example", preflight: true, wantStatus: 204, wantAllowOrigin: "https://shop.example", wantMaxAge: "3600"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			served := false
+			handler := CORS(test.options)(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+				served = true
+			}))
+			request := httptest.NewRequest(test.method, "/product", nil)
+			request.Header.Set("Origin", test.origin)
+			if test.preflight {
+				request.Header.Set("Access-Control-Request-Method", "POST")
+			}
+			response := httptest.NewRecorder()
+			handler.ServeHTTP(response, request)
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if served != test.wantServed {
+				t.Errorf("served = %v, want %v", served, test.wantServed)
+			}
+			if value := response.Header().Get("Access-Control-Allow-Origin"); value != test.wantAllowOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", value, test.wantAllowOrigin)
+			}
+			if value := response.Header().Get("Access-Control-Max-Age"); value != test.wantMaxAge {
+				t.Errorf("Access-Control-Max-Age = %q, want %q", value, test.wantMaxAge)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func Deprecated(sunset time.Time) func(http.Handler) http.Handler {
+	sunsetDate := sunset.UTC().Format(http.TimeFormat)
+	warning := fmt.Sprintf(`299 - "Deprecated API: this route will be removed after %s"`, sunsetDate)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			response.Header().Set("Deprecation", "true")
+			response.Header().Set("Sunset", sunsetDate)
+			response.Header().Add("Warning", warning)
+			next.ServeHTTP(response, request)
+		})
+	}
+}
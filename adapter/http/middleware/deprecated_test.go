@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+func TestDeprecated(t *testing.T) {
+	ok := http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {})
+	router := mux.NewRouter()
+	v1 := router.PathPrefix("/v1").Subrouter()
+	v1.Use(Deprecated(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)))
+	v1.Handle("/product", ok)
+	router.Handle("/v2/product", ok)
+
+	tests := []struct {
+		name           string
+		path           string
+		wantDeprecated bool
+	}{
+		{name: "wrapped", path: "/v1/product", wantDeprecated: true},
+		{name: "not wrapped", path: "/v2/product"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := httptest.NewRecorder()
+			router.ServeHTTP(response, httptest.NewRequest("GET", test.path, nil))
+
+			header := response.Header()
+			if !test.wantDeprecated {
+				for _, name := range []string{"Deprecation", "Sunset", "Warning"} {
+					if value := header.Get(name); value != "" {
+						t.Errorf("%s = %q, want it absent", name, value)
+					}
+				}
+				return
+			}
+			if value := header.Get("Deprecation"); value != "true" {
+				t.Errorf("Deprecation = %q, want true", value)
+			}
+			if value := header.Get("Sunset"); value != "Fri, 31 Jan 2025 00:00:00 GMT" {
+				t.Errorf("Sunset = %q", value)
+			}
+			if value := header.Get("Warning"); value != `299 - "Deprecated API: this route will be removed after Fri, 31 Jan 2025 00:00:00 GMT"` {
+				t.Errorf("Warning = %q", value)
+			}
+		})
+	}
+}
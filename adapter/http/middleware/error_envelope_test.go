@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gorilla/mux"
+)
+
+func TestErrorsUseTheEnvelope(t *testing.T) {
+	slow := http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		<-request.Context().Done()
+	})
+	router := mux.NewRouter()
+	router.Handle("/product", slow).Methods("GET")
+	router.MethodNotAllowedHandler = MethodNotAllowed(router)
+	router.NotFoundHandler = NotFound()
+
+	tests := []struct {
+		name       string
+		handler    http.Handler
+		request    func() *http.Request
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "timeout",
+			handler:    Timeout(time.Millisecond)(slow),
+			request:    func() *http.Request { return httptest.NewRequest("GET", "/product", nil) },
+			wantStatus: http.StatusGatewayTimeout,
+			wantCode:   dto.ErrorCodeTimeout,
+		},
+		{
+			name:    "invalid request timeout",
+			handler: RouteTimeout(TimeoutOptions{MaxClient: time.Second})(slow),
+			request: func() *http.Request {
+				request := httptest.NewRequest("GET", "/product", nil)
+				request.Header.Set(RequestTimeoutHeader, "soon")
+				return request
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   dto.ErrorCodeBadRequest,
+		},
+		{
+			name:       "admin without token",
+			handler:    Admin("secret")(slow),
+			request:    func() *http.Request { return httptest.NewRequest("POST", "/admin/reindex", nil) },
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   dto.ErrorCodeUnauthorized,
+		},
+		{
+			name:       "method not allowed",
+			handler:    router,
+			request:    func() *http.Request { return httptest.NewRequest("DELETE", "/product", nil) },
+			wantStatus: http.StatusMethodNotAllowed,
+			wantCode:   dto.ErrorCodeMethodNotAllowed,
+		},
+		{
+			name:       "not found",
+			handler:    router,
+			request:    func() *http.Request { return httptest.NewRequest("GET", "/nowhere", nil) },
+			wantStatus: http.StatusNotFound,
+			wantCode:   dto.ErrorCodeNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := httptest.NewRecorder()
+			test.handler.ServeHTTP(response, test.request())
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if contentType := response.Header().Get("Content-Type"); contentType != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", contentType)
+			}
+			errorResponse := dto.ErrorResponse{}
+			if err := json.Unmarshal(response.Body.Bytes(), &errorResponse); err != nil {
+				t.Fatalf("body %q is not the error envelope: %v", response.Body.String(), err)
+			}
+			if errorResponse.Code != test.wantCode || errorResponse.Message == "" {
+				t.Errorf("error = %+v, want code %s with a message", errorResponse, test.wantCode)
+			}
+		})
+	}
+}
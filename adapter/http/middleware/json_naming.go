@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+const (
+	JSONNamingCamel = "camel"
+	JSONNamingSnake = "snake"
+)
+
+// JSONNaming rewrites the object keys of JSON responses to the configured
+// casing. The response DTOs are tagged in camelCase, so the camel policy, or
+// an empty one, leaves responses untouched; snake buffers each JSON response
+// and converts its keys, keeping their order.
+func JSONNaming(policy string) (func(http.Handler) http.Handler, error) {
+	switch policy {
+	case "", JSONNamingCamel:
+		return func(next http.Handler) http.Handler { return next }, nil
+	case JSONNamingSnake:
+	default:
+		return nil, fmt.Errorf("invalid json naming policy %q: expected %s or %s", policy, JSONNamingCamel, JSONNamingSnake)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			buffered := &namingWriter{ResponseWriter: response, status: http.StatusOK}
+			next.ServeHTTP(buffered, request)
+
+			body := buffered.body.Bytes()
+			if strings.HasPrefix(response.Header().Get("Content-Type"), "application/json") && len(body) > 0 {
+				if converted, err := snakeCaseKeys(body); err == nil {
+					body = converted
+				}
+			}
+			response.WriteHeader(buffered.status)
+			response.Write(body)
+		})
+	}, nil
+}
+
+type namingWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (writer *namingWriter) WriteHeader(status int) {
+	writer.status = status
+}
+
+func (writer *namingWriter) Write(data []byte) (int, error) {
+	return writer.body.Write(data)
+}
+
+type jsonFrame struct {
+	object    bool
+	expectKey bool
+	count     int
+}
+
+// snakeCaseKeys re-emits data token by token, converting object keys.
+func snakeCaseKeys(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	out := &bytes.Buffer{}
+	stack := []jsonFrame{}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		closing := token == json.Delim('}') || token == json.Delim(']')
+		isKey := false
+		if len(stack) > 0 && !closing {
+			frame := &stack[len(stack)-1]
+			switch {
+			case frame.object && frame.expectKey:
+				isKey = true
+				if frame.count > 0 {
+					out.WriteByte(',')
+				}
+			case frame.object:
+				out.WriteByte(':')
+			case frame.count > 0:
+				out.WriteByte(',')
+			}
+		}
+
+		switch value := token.(type) {
+		case json.Delim:
+			out.WriteRune(rune(value))
+			if !closing {
+				stack = append(stack, jsonFrame{object: value == '{', expectKey: true})
+				continue
+			}
+			stack = stack[:len(stack)-1]
+		case string:
+			if isKey {
+				value = toSnakeCase(value)
+			}
+			encoded, _ := json.Marshal(value)
+			out.Write(encoded)
+		case json.Number:
+			out.WriteString(value.String())
+		default:
+			encoded, _ := json.Marshal(value)
+			out.Write(encoded)
+		}
+
+		if len(stack) > 0 {
+			frame := &stack[len(stack)-1]
+			if isKey {
+				frame.expectKey = false
+				continue
+			}
+			frame.expectKey = true
+			frame.count++
+		}
+	}
+
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	builder := strings.Builder{}
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			previousLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			acronymEnd := i > 0 && unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if previousLower || acronymEnd {
+				builder.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestJSONNaming(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		contentType string
+		body        string
+		wantBody    string
+	}{
+		{name: "camel", policy: JSONNamingCamel, contentType: "application/json", body: `{"itemsPerPage":1}`, wantBody: `{"itemsPerPage":1}`},
+		{name: "snake", policy: JSONNamingSnake, contentType: "application/json", body: `{"itemsPerPage":1,"items":[{"effectivePrice":2}]}`, wantBody: "{\"items_per_page\":1,\"items\":[{\"effective_price\":2}]}\n"},
+		{name: "snake leaves other content alone", policy: JSONNamingSnake, contentType: "text/plain", body: `{"itemsPerPage":1}`, wantBody: `{"itemsPerPage":1}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			naming, err := JSONNaming(test.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			handler := naming(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+				response.Header().Set("Content-Type", test.contentType)
+				response.WriteHeader(201)
+				response.Write([]byte(test.body))
+			}))
+			response := httptest.NewRecorder()
+			handler.ServeHTTP(response, httptest.NewRequest("GET", "/product", nil))
+
+			if response.Code != 201 {
+				t.Errorf("status = %d, want 201", response.Code)
+			}
+			if body := response.Body.String(); body != test.wantBody {
+				t.Errorf("body = %s, want %s", body, test.wantBody)
+			}
+		})
+	}
+}
+
+func TestJSONNamingRejectsUnknownPolicies(t *testing.T) {
+	if _, err := JSONNaming("kebab"); err == nil {
+		t.Error("JSONNaming() error = nil, want an invalid policy error")
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gorilla/mux"
+)
+
+var routableMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// MethodNotAllowed answers with 405 and an Allow header naming the methods
+// router does serve for the request path. Install it as the router's
+// MethodNotAllowedHandler.
+func MethodNotAllowed(router *mux.Router) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		allowed := []string{}
+		for _, method := range routableMethods {
+			probe := request.Clone(request.Context())
+			probe.Method = method
+			match := mux.RouteMatch{}
+			if router.Match(probe, &match) && match.MatchErr == nil {
+				allowed = append(allowed, method)
+			}
+		}
+
+		response.Header().Set("Allow", strings.Join(allowed, ", "))
+		respond.Error(response, request, http.StatusMethodNotAllowed, dto.ErrorCodeMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+	})
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+func TestMethodNotAllowed(t *testing.T) {
+	ok := http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {})
+	router := mux.NewRouter()
+	router.Handle("/product", ok).Methods("GET")
+	router.Handle("/product", ok).Methods("POST")
+	router.Handle("/product/{id}", ok).Methods("GET")
+	router.Handle("/product/{id}", ok).Methods("DELETE")
+	router.MethodNotAllowedHandler = MethodNotAllowed(router)
+
+	tests := []struct {
+		method    string
+		path      string
+		wantAllow string
+	}{
+		{"DELETE", "/product", "GET, POST"},
+		{"PUT", "/product/1", "GET, DELETE"},
+	}
+	for _, test := range tests {
+		t.Run(test.method+" "+test.path, func(t *testing.T) {
+			response := httptest.NewRecorder()
+			router.ServeHTTP(response, httptest.NewRequest(test.method, test.path, nil))
+
+			if response.Code != http.StatusMethodNotAllowed {
+				t.Errorf("status = %d, want %d", response.Code, http.StatusMethodNotAllowed)
+			}
+			if allow := response.Header().Get("Allow"); allow != test.wantAllow {
+				t.Errorf("Allow = %q, want %q", allow, test.wantAllow)
+			}
+		})
+	}
+}
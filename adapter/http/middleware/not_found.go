@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// NotFound answers requests that match no route with a JSON error. Install
+// it as the router's NotFoundHandler.
+func NotFound() http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		respond.Error(response, request, http.StatusNotFound, dto.ErrorCodeNotFound, "no route matches the request path")
+	})
+}
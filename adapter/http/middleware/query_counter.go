@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+)
+
+const DBQueriesHeader = "X-DB-Queries"
+
+// QueryCounter reports in X-DB-Queries how many queries the request ran
+// through a postgres.CountQueries pool. The header goes out with the status
+// line, so queries made after the response starts are not included.
+func QueryCounter() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			request = request.WithContext(postgres.WithQueryCounter(request.Context()))
+			next.ServeHTTP(&queryCountWriter{ResponseWriter: response, request: request}, request)
+		})
+	}
+}
+
+type queryCountWriter struct {
+	http.ResponseWriter
+	request     *http.Request
+	wroteHeader bool
+}
+
+func (writer *queryCountWriter) WriteHeader(status int) {
+	if !writer.wroteHeader {
+		writer.wroteHeader = true
+		writer.Header().Set(DBQueriesHeader, strconv.FormatInt(postgres.QueryCount(writer.request.Context()), 10))
+	}
+	writer.ResponseWriter.WriteHeader(status)
+}
+
+func (writer *queryCountWriter) Write(data []byte) (int, error) {
+	if !writer.wroteHeader {
+		writer.WriteHeader(http.StatusOK)
+	}
+	return writer.ResponseWriter.Write(data)
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres/productrepository"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// emptyPool answers listings with no rows and counts with zero.
+type emptyPool struct {
+	countPool
+}
+
+func (emptyPool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return &emptyRows{}, nil
+}
+
+// emptyRows is a result without rows; only the methods Fetch calls are
+// implemented.
+type emptyRows struct {
+	pgx.Rows
+}
+
+func (*emptyRows) Next() bool { return false }
+func (*emptyRows) Err() error { return nil }
+func (*emptyRows) Close()     {}
+
+func TestQueryCounter(t *testing.T) {
+	repository := productrepository.New(postgres.CountQueries(emptyPool{}), productrepository.Options{})
+	handler := QueryCounter()(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		if _, err := repository.Fetch(request.Context(), dto.DefaultPaginationRequestParams()); err != nil {
+			t.Errorf("Fetch() error = %v", err)
+		}
+		response.Write([]byte("[]"))
+	}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest("GET", "/product", nil))
+
+	// One query for the page and one for its total.
+	if got := response.Header().Get(DBQueriesHeader); got != "2" {
+		t.Errorf("%s = %q, want 2", DBQueriesHeader, got)
+	}
+}
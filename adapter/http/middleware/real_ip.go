@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// RealIP stores the client address in the request context. X-Forwarded-For
+// is only believed when the connection comes from one of trustedProxies,
+// given as CIDRs or single addresses; otherwise RemoteAddr is used. Within
+// the header, the rightmost address that is not itself a trusted proxy wins,
+// since entries to its left were supplied by the client.
+func RealIP(trustedProxies []string) (func(http.Handler) http.Handler, error) {
+	trusted := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		network, err := parseNetwork(strings.TrimSpace(proxy))
+		if err != nil {
+			return nil, err
+		}
+		trusted = append(trusted, network)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			ip := clientIP(request, trusted)
+			ctx := context.WithValue(request.Context(), clientIPKey{}, ip)
+			next.ServeHTTP(response, request.WithContext(ctx))
+		})
+	}, nil
+}
+
+// ClientIP returns the address stored by RealIP, or "" when it did not run.
+func ClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+func clientIP(request *http.Request, trusted []*net.IPNet) string {
+	remote := request.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	if !isTrusted(net.ParseIP(remote), trusted) {
+		return remote
+	}
+
+	forwarded := strings.Split(strings.Join(request.Header.Values("X-Forwarded-For"), ","), ",")
+	ip := remote
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		candidate := strings.TrimSpace(forwarded[i])
+		parsed := net.ParseIP(candidate)
+		if parsed == nil {
+			break
+		}
+		ip = candidate
+		if !isTrusted(parsed, trusted) {
+			break
+		}
+	}
+	return ip
+}
+
+func isTrusted(ip net.IP, trusted []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseNetwork(value string) (*net.IPNet, error) {
+	if strings.Contains(value, "/") {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+		}
+		return network, nil
+	}
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid trusted proxy %q: expected a CIDR or an IP address", value)
+	}
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRealIP(t *testing.T) {
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		wantIP       string
+	}{
+		{name: "direct client", remoteAddr: "203.0.113.7:4000", wantIP: "203.0.113.7"},
+		{name: "untrusted forwarder", remoteAddr: "203.0.113.7:4000", forwardedFor: "198.51.100.1", wantIP: "203.0.113.7"},
+		{name: "trusted proxy", remoteAddr: "10.0.0.2:4000", forwardedFor: "198.51.100.1", wantIP: "198.51.100.1"},
+		{name: "spoofed entry left of the client", remoteAddr: "10.0.0.2:4000", forwardedFor: "192.0.2.9, 198.51.100.1, 10.0.0.3", wantIP: "198.51.100.1"},
+	}
+	realIP, err := RealIP([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := ""
+			handler := realIP(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+				got = ClientIP(request.Context())
+			}))
+			request := httptest.NewRequest("GET", "/product", nil)
+			request.RemoteAddr = test.remoteAddr
+			if test.forwardedFor != "" {
+				request.Header.Set("X-Forwarded-For", test.forwardedFor)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), request)
+
+			if got != test.wantIP {
+				t.Errorf("ClientIP() = %q, want %q", got, test.wantIP)
+			}
+		})
+	}
+}
+
+func TestRealIPRejectsInvalidProxies(t *testing.T) {
+	if _, err := RealIP([]string{"not-an-ip"}); err == nil {
+		t.Error("RealIP() error = nil, want an invalid proxy error")
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gorilla/mux"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// RequestLogger stores a logger carrying the request id, method and route,
+// plus the client address when RealIP runs first, in the request context.
+// The id is taken from X-Request-ID when the client sends one and echoed
+// back in the response.
+func RequestLogger() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			requestID := request.Header.Get(RequestIDHeader)
+			if requestID == "" {
+				requestID = newRequestID()
+			}
+			response.Header().Set(RequestIDHeader, requestID)
+
+			route := request.URL.Path
+			if current := mux.CurrentRoute(request); current != nil {
+				if name := current.GetName(); name != "" {
+					route = name
+				} else if template, err := current.GetPathTemplate(); err == nil {
+					route = template
+				}
+			}
+
+			fields := []any{"requestId", requestID, "method", request.Method, "route", route}
+			if ip := ClientIP(request.Context()); ip != "" {
+				fields = append(fields, "clientIp", ip)
+			}
+			ctx := logging.WithFields(request.Context(), fields...)
+			next.ServeHTTP(response, request.WithContext(ctx))
+		})
+	}
+}
+
+func newRequestID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(id)
+}
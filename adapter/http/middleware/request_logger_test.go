@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres/productrepository"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// countPool answers every query with a count of zero; any other call panics
+// on the nil embedded interface.
+type countPool struct {
+	postgres.PoolInterface
+}
+
+func (countPool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return zeroRow{}
+}
+
+type zeroRow struct{}
+
+func (zeroRow) Scan(dest ...interface{}) error {
+	*dest[0].(*int32) = 0
+	return nil
+}
+
+func TestRequestLoggerFieldsReachTheRepository(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	repository := productrepository.New(countPool{}, productrepository.Options{})
+	handler := RequestLogger()(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		if _, err := repository.Count(request.Context(), dto.DefaultPaginationRequestParams()); err != nil {
+			t.Errorf("Count() error = %v", err)
+		}
+	}))
+	request := httptest.NewRequest("GET", "/product", nil)
+	request.Header.Set(RequestIDHeader, "req-42")
+	request = request.WithContext(logging.WithLogger(request.Context(), logger))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+
+	if got := response.Header().Get(RequestIDHeader); got != "req-42" {
+		t.Errorf("%s = %q, want req-42", RequestIDHeader, got)
+	}
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) == 0 || !strings.Contains(output.String(), "Counting products") {
+		t.Fatalf("log = %q, want the repository's count log", output.String())
+	}
+	for _, line := range lines {
+		for _, field := range []string{"requestId=req-42", "method=GET", "route=/product"} {
+			if !strings.Contains(line, field) {
+				t.Errorf("log line %q is missing %s", line, field)
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gorilla/mux"
+)
+
+func Timeout(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if timeout <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			serveWithTimeout(next, timeout, response, request)
+		})
+	}
+}
+
+const RequestTimeoutHeader = "X-Request-Timeout"
+
+type TimeoutOptions struct {
+	Default time.Duration
+	// Routes overrides Default by lowercased route name.
+	Routes map[string]time.Duration
+	// MaxClient, when set, lets clients replace the timeout with
+	// X-Request-Timeout, in milliseconds, capped at MaxClient.
+	MaxClient time.Duration
+	// Budget, when set, caps every other timeout, so no request holds a
+	// database connection longer than it.
+	Budget time.Duration
+}
+
+// RouteTimeout bounds each request by its route's timeout, or the default,
+// answering 504 and cancelling the request context, and with it any
+// in-flight query, once it expires.
+func RouteTimeout(options TimeoutOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			timeout := options.Default
+			if route := mux.CurrentRoute(request); route != nil {
+				if override, ok := options.Routes[strings.ToLower(route.GetName())]; ok {
+					timeout = override
+				}
+			}
+			if value := request.Header.Get(RequestTimeoutHeader); value != "" && options.MaxClient > 0 {
+				milliseconds, err := strconv.ParseInt(value, 10, 64)
+				if err != nil || milliseconds < 1 {
+					respond.Error(response, request, http.StatusBadRequest, dto.ErrorCodeBadRequest, "invalid "+RequestTimeoutHeader+" value: expected a positive number of milliseconds")
+					return
+				}
+				timeout = options.MaxClient
+				if milliseconds < options.MaxClient.Milliseconds() {
+					timeout = time.Duration(milliseconds) * time.Millisecond
+				}
+			}
+			if options.Budget > 0 && (timeout <= 0 || timeout > options.Budget) {
+				timeout = options.Budget
+			}
+			if timeout <= 0 {
+				next.ServeHTTP(response, request)
+				return
+			}
+			serveWithTimeout(next, timeout, response, request)
+		})
+	}
+}
+
+func serveWithTimeout(next http.Handler, timeout time.Duration, response http.ResponseWriter, request *http.Request) {
+	ctx, cancel := context.WithTimeout(request.Context(), timeout)
+	defer cancel()
+
+	writer := &timeoutWriter{header: http.Header{}}
+	done := make(chan struct{})
+	panics := make(chan any, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				panics <- p
+			}
+		}()
+		next.ServeHTTP(writer, request.WithContext(ctx))
+		close(done)
+	}()
+
+	select {
+	case p := <-panics:
+		panic(p)
+	case <-done:
+		writer.mu.Lock()
+		defer writer.mu.Unlock()
+		for key, values := range writer.header {
+			response.Header()[key] = values
+		}
+		if writer.status == 0 {
+			writer.status = http.StatusOK
+		}
+		response.WriteHeader(writer.status)
+		response.Write(writer.body.Bytes())
+	case <-ctx.Done():
+		writer.mu.Lock()
+		defer writer.mu.Unlock()
+		writer.timedOut = true
+		respond.Error(response, request, http.StatusGatewayTimeout, dto.ErrorCodeTimeout, http.StatusText(http.StatusGatewayTimeout))
+	}
+}
+
+type timeoutWriter struct {
+	mu       sync.Mutex
+	header   http.Header
+	body     bytes.Buffer
+	status   int
+	timedOut bool
+}
+
+func (writer *timeoutWriter) Header() http.Header {
+	return writer.header
+}
+
+func (writer *timeoutWriter) Write(body []byte) (int, error) {
+	writer.mu.Lock()
+	defer writer.mu.Unlock()
+	if writer.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if writer.status == 0 {
+		writer.status = http.StatusOK
+	}
+	return writer.body.Write(body)
+}
+
+func (writer *timeoutWriter) WriteHeader(status int) {
+	writer.mu.Lock()
+	defer writer.mu.Unlock()
+	if writer.timedOut || writer.status != 0 {
+		return
+	}
+	writer.status = status
+}
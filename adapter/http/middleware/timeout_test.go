@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres/productrepository"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestRouteTimeout(t *testing.T) {
+	tests := []struct {
+		name          string
+		options       TimeoutOptions
+		clientTimeout string
+		wantDeadline  time.Duration
+	}{
+		{name: "default", options: TimeoutOptions{Default: 2 * time.Second}, wantDeadline: 2 * time.Second},
+		{name: "route override", options: TimeoutOptions{Default: 2 * time.Second, Routes: map[string]time.Duration{"fetchproducts": 5 * time.Second}}, wantDeadline: 5 * time.Second},
+		{name: "client timeout", options: TimeoutOptions{Default: 2 * time.Second, MaxClient: 10 * time.Second}, clientTimeout: "500", wantDeadline: 500 * time.Millisecond},
+		{name: "client timeout capped", options: TimeoutOptions{MaxClient: 3 * time.Second}, clientTimeout: "60000", wantDeadline: 3 * time.Second},
+		{name: "client timeout ignored", options: TimeoutOptions{Default: 2 * time.Second}, clientTimeout: "60000", wantDeadline: 2 * time.Second},
+		{name: "budget", options: TimeoutOptions{Default: 20 * time.Second, Budget: 4 * time.Second}, wantDeadline: 4 * time.Second},
+		{name: "none"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var remaining time.Duration
+			router := mux.NewRouter()
+			router.Use(RouteTimeout(test.options))
+			router.HandleFunc("/product", func(response http.ResponseWriter, request *http.Request) {
+				if deadline, ok := request.Context().Deadline(); ok {
+					remaining = time.Until(deadline)
+				}
+				response.WriteHeader(201)
+			}).Name("fetchProducts")
+
+			request := httptest.NewRequest("GET", "/product", nil)
+			if test.clientTimeout != "" {
+				request.Header.Set(RequestTimeoutHeader, test.clientTimeout)
+			}
+			response := httptest.NewRecorder()
+			router.ServeHTTP(response, request)
+
+			if response.Code != 201 {
+				t.Errorf("status = %d, want 201", response.Code)
+			}
+			if remaining > test.wantDeadline || remaining < test.wantDeadline-time.Second/2 {
+				t.Errorf("deadline in %v, want about %v", remaining, test.wantDeadline)
+			}
+		})
+	}
+}
+
+// hangingPool blocks every query until its context ends and reports the
+// context's error on cancelled.
+type hangingPool struct {
+	postgres.PoolInterface
+	cancelled chan error
+}
+
+func (pool hangingPool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	<-ctx.Done()
+	pool.cancelled <- ctx.Err()
+	return nil, ctx.Err()
+}
+
+func TestBudgetCancelsTheQuery(t *testing.T) {
+	pool := hangingPool{cancelled: make(chan error, 1)}
+	repository := productrepository.New(pool, productrepository.Options{})
+	router := mux.NewRouter()
+	router.Use(RouteTimeout(TimeoutOptions{Default: time.Minute, Budget: 20 * time.Millisecond}))
+	router.HandleFunc("/product", func(response http.ResponseWriter, request *http.Request) {
+		repository.Fetch(request.Context(), dto.DefaultPaginationRequestParams())
+	}).Name("fetchProducts")
+
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest("GET", "/product", nil))
+
+	if response.Code != http.StatusGatewayTimeout {
+		t.Errorf("status = %d, want %d", response.Code, http.StatusGatewayTimeout)
+	}
+	select {
+	case err := <-pool.cancelled:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("query context error = %v, want %v", err, context.DeadlineExceeded)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the query was still running a second after the budget expired")
+	}
+}
@@ -0,0 +1,24 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (service service) AdjustPrices(response http.ResponseWriter, request *http.Request) {
+	adjustPriceRequest, err := dto.FromJSONAdjustPriceRequest(request.Body, service.options.JSONLimits)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	affected, err := service.usecase.AdjustPrices(request.Context(), adjustPriceRequest)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, 200, map[string]any{"updated": affected})
+}
@@ -0,0 +1,76 @@
+package productservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+// adjustingRepository records the adjustments it is asked to apply and
+// reports updated rows for each.
+type adjustingRepository struct {
+	domain.ProductRepository
+	updated     int64
+	adjustments []dto.AdjustPriceRequest
+}
+
+func (repository *adjustingRepository) AdjustPrices(ctx context.Context, adjustPriceRequest *dto.AdjustPriceRequest) (int64, error) {
+	repository.adjustments = append(repository.adjustments, *adjustPriceRequest)
+	return repository.updated, nil
+}
+
+func TestAdjustPrices(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantStatus  int
+		wantUpdated int64
+		wantFactor  float64
+	}{
+		{name: "ten percent up", body: `{"percentage":10,"nameContains":"chair"}`, wantStatus: 200, wantUpdated: 3, wantFactor: 1.1},
+		{name: "minus one hundred percent", body: `{"percentage":-100,"nameContains":"chair"}`, wantStatus: 400},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repository := &adjustingRepository{updated: 3}
+			harness := testutil.New(productusecase.New(repository, productusecase.Options{}), productservice.Options{})
+
+			response := harness.Do("POST", "/product/adjust-price", test.body)
+
+			if response.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", response.Code, test.wantStatus, response.Body.String())
+			}
+			if test.wantStatus != 200 {
+				if len(repository.adjustments) != 0 {
+					t.Errorf("repository applied %d adjustments, want none", len(repository.adjustments))
+				}
+				return
+			}
+			var body struct {
+				Updated int64 `json:"updated"`
+			}
+			if err := json.Unmarshal(response.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			if body.Updated != test.wantUpdated {
+				t.Errorf("updated = %d, want %d", body.Updated, test.wantUpdated)
+			}
+			if len(repository.adjustments) != 1 {
+				t.Fatalf("repository applied %d adjustments, want 1", len(repository.adjustments))
+			}
+			adjustment := repository.adjustments[0]
+			if factor := adjustment.Factor(); factor < test.wantFactor-1e-6 || factor > test.wantFactor+1e-6 {
+				t.Errorf("factor = %v, want %v", factor, test.wantFactor)
+			}
+			if adjustment.NameContains != "chair" {
+				t.Errorf("nameContains = %q, want %q", adjustment.NameContains, "chair")
+			}
+		})
+	}
+}
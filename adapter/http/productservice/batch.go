@@ -0,0 +1,31 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
+)
+
+func (service service) Batch(response http.ResponseWriter, request *http.Request) {
+	request, err := withPricing(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	batchRequest, err := dto.FromJSONBatchRequest(request.Body, service.isStrict(request), service.options.JSONLimits)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	results, err := service.usecase.Batch(request.Context(), batchRequest)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, http.StatusMultiStatus, map[string]any{"results": mapper.ToBatchResultResponses(results)})
+}
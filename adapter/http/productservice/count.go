@@ -0,0 +1,35 @@
+package productservice
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+const TotalCountHeader = "X-Total-Count"
+
+// Count answers HEAD /product with the headers of the matching listing,
+// running only its count query.
+func (service service) Count(response http.ResponseWriter, request *http.Request) {
+	paginationRequest, err := dto.FromValuePaginationRequestParams(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	products, err := service.usecase.Count(request.Context(), paginationRequest)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	writeTotalCount(response, products.Total)
+	writePaginationLinks(response, request, paginationRequest.Page, paginationRequest.ItemsPerPage, products.Total)
+	response.WriteHeader(200)
+}
+
+func writeTotalCount(response http.ResponseWriter, total int32) {
+	response.Header().Set(TotalCountHeader, strconv.Itoa(int(total)))
+}
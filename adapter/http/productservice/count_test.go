@@ -0,0 +1,48 @@
+package productservice_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantTotal  string
+	}{
+		{name: "counted", path: "/product?name=Lamp", wantStatus: 200, wantTotal: "3"},
+		{name: "page and offset", path: "/product?page=2&offset=10", wantStatus: 400},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			harness := testutil.New(testutil.UseCase{
+				CountFunc: func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+					return &domain.Pagination[[]domain.Product]{Total: 3}, nil
+				},
+			}, productservice.Options{})
+
+			response := harness.Do("HEAD", test.path, "")
+
+			if response.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if total := response.Header().Get(productservice.TotalCountHeader); total != test.wantTotal {
+				t.Errorf("%s = %q, want %q", productservice.TotalCountHeader, total, test.wantTotal)
+			}
+			if test.wantStatus != 400 {
+				return
+			}
+			errorResponse := dto.ErrorResponse{}
+			if err := json.Unmarshal(response.Body.Bytes(), &errorResponse); err != nil || errorResponse.Code != dto.ErrorCodeBadRequest {
+				t.Errorf("body = %q, want the %s error envelope", response.Body.String(), dto.ErrorCodeBadRequest)
+			}
+		})
+	}
+}
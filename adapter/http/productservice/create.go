@@ -1,25 +1,57 @@
 package productservice
 
 import (
-	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
 	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
 )
 
 func (service service) Create(response http.ResponseWriter, request *http.Request) {
-	productRequest, err := dto.FromJSONCreateProductRequest(request.Body)
+	request, err := withPricing(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	productRequest, err := dto.FromJSONCreateProductRequest(request.Body, service.isStrict(request))
 
 	if err != nil {
-		response.WriteHeader(500)
-		response.Write([]byte(err.Error()))
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	if isDryRun(request) {
+		product, err := service.usecase.Preview(request.Context(), productRequest)
+		if err != nil {
+			writeError(response, request, err)
+			return
+		}
+		respond.JSON(response, request, 200, mapper.ToProductResponse(product))
+		return
+	}
+
+	if hasPreference(request, "return=minimal") {
+		id, err := service.usecase.CreateID(request.Context(), productRequest)
+		if err != nil {
+			writeError(response, request, err)
+			return
+		}
+		response.Header().Set("Location", strings.TrimSuffix(request.URL.Path, "/")+"/"+strconv.Itoa(int(id)))
+		response.Header().Set("Preference-Applied", "return=minimal")
+		respond.JSON(response, request, 201, dto.CreatedProductResponse{ID: id})
+		return
 	}
 
-	product, err := service.usecase.Create(productRequest)
+	product, err := service.usecase.Create(request.Context(), productRequest)
 	if err != nil {
-		response.WriteHeader(500)
-		response.Write([]byte(err.Error()))
+		writeError(response, request, err)
+		return
 	}
 
-	json.NewEncoder(response).Encode(product)
+	response.Header().Set("Location", strings.TrimSuffix(request.URL.Path, "/")+"/"+strconv.Itoa(int(product.ID)))
+	respond.JSON(response, request, 201, mapper.ToProductResponse(product))
 }
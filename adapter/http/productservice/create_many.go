@@ -0,0 +1,41 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
+)
+
+func (service service) CreateMany(response http.ResponseWriter, request *http.Request) {
+	request, err := withPricing(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	productRequests, err := dto.FromJSONCreateProductsRequest(request.Body, service.isStrict(request))
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	if isDryRun(request) {
+		products, err := service.usecase.PreviewMany(request.Context(), productRequests)
+		if err != nil {
+			writeError(response, request, err)
+			return
+		}
+		respond.JSON(response, request, 200, mapper.ToProductResponses(products))
+		return
+	}
+
+	created, err := service.usecase.CreateMany(request.Context(), productRequests)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, 201, map[string]int64{"created": created})
+}
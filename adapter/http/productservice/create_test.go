@@ -0,0 +1,162 @@
+package productservice_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+func TestCreate(t *testing.T) {
+	harness := testutil.New(testutil.UseCase{
+		CreateFunc: func(productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+			return &domain.Product{ID: 12, Name: productRequest.Name, Price: productRequest.Price, Description: productRequest.Description}, nil
+		},
+	}, productservice.Options{})
+
+	response := harness.Do("POST", "/product", `{"name":"Lamp","price":10,"description":"Desk lamp"}`)
+
+	if response.Code != 201 {
+		t.Fatalf("status = %d, want 201 (body %q)", response.Code, response.Body.String())
+	}
+	if location := response.Header().Get("Location"); location != "/product/12" {
+		t.Errorf("Location = %q, want /product/12", location)
+	}
+	product := dto.ProductResponse{}
+	if err := json.Unmarshal(response.Body.Bytes(), &product); err != nil {
+		t.Fatalf("body %q: %v", response.Body.String(), err)
+	}
+	if product.ID != 12 || product.Name != "Lamp" || product.Price != 10 || product.Description != "Desk lamp" {
+		t.Errorf("product = %+v", product)
+	}
+}
+
+func TestCreateDecoding(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefer      string
+		options     productservice.Options
+		wantStatus  int
+		wantCreated bool
+	}{
+		{name: "lenient ignores unknown fields", wantStatus: 201, wantCreated: true},
+		{name: "strict preference rejects unknown fields", prefer: "strict", wantStatus: 400},
+		{name: "strict option rejects unknown fields", options: productservice.Options{StrictDecoding: true}, wantStatus: 400},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			created := false
+			harness := testutil.New(testutil.UseCase{
+				CreateFunc: func(productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+					created = true
+					return &domain.Product{ID: 1, Name: productRequest.Name}, nil
+				},
+			}, test.options)
+
+			request := httptest.NewRequest("POST", "/product", strings.NewReader(`{"name":"Lamp","pice":10}`))
+			if test.prefer != "" {
+				request.Header.Set("Prefer", test.prefer)
+			}
+			response := harness.DoRequest(request)
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if created != test.wantCreated {
+				t.Errorf("created = %v, want %v", created, test.wantCreated)
+			}
+			if test.wantStatus == 400 {
+				errorResponse := dto.ErrorResponse{}
+				if err := json.Unmarshal(response.Body.Bytes(), &errorResponse); err != nil || !strings.Contains(errorResponse.Message, `"pice"`) {
+					t.Errorf("body = %q, want an error naming the unknown field", response.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestCreateReportsEveryFailingField(t *testing.T) {
+	repository := &writeCountingRepository{}
+	harness := testutil.New(productusecase.New(repository, productusecase.Options{}), productservice.Options{})
+
+	response := harness.Do("POST", "/product", `{"name":"","price":0,"description":"Lamp","discount":{"type":"bogus","value":5}}`)
+
+	if response.Code != 400 {
+		t.Fatalf("status = %d, want 400", response.Code)
+	}
+	validationError := dto.ValidationError{}
+	if err := json.Unmarshal(response.Body.Bytes(), &validationError); err != nil {
+		t.Fatalf("body %q: %v", response.Body.String(), err)
+	}
+	want := []dto.FieldError{
+		{Field: "name", Rule: "required", Message: "is required"},
+		{Field: "price", Rule: "gt", Message: "must be greater than 0"},
+		{Field: "discount.type", Rule: "oneof", Message: "must be percentage or fixed"},
+	}
+	if !reflect.DeepEqual(validationError.Errors, want) {
+		t.Errorf("errors = %+v, want %+v", validationError.Errors, want)
+	}
+	if repository.writes != 0 {
+		t.Errorf("repository writes = %d, want none", repository.writes)
+	}
+}
+
+func TestCreateLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefer      string
+		wantMinimal bool
+	}{
+		{name: "default"},
+		{name: "full representation", prefer: "return=representation"},
+		{name: "minimal representation", prefer: "return=minimal", wantMinimal: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repository := &writeCountingRepository{}
+			harness := testutil.New(productusecase.New(repository, productusecase.Options{}), productservice.Options{})
+			request := httptest.NewRequest("POST", "/product", strings.NewReader(`{"name":"Lamp","price":10,"description":"Desk lamp"}`))
+			if test.prefer != "" {
+				request.Header.Set("Prefer", test.prefer)
+			}
+
+			response := harness.DoRequest(request)
+
+			if response.Code != 201 {
+				t.Fatalf("status = %d, want 201 (body %q)", response.Code, response.Body.String())
+			}
+			if location := response.Header().Get("Location"); location != "/product/1" {
+				t.Errorf("Location = %q, want /product/1", location)
+			}
+			if repository.writes != 1 {
+				t.Errorf("repository writes = %d, want 1", repository.writes)
+			}
+			if test.wantMinimal {
+				if repository.idWrites != 1 {
+					t.Error("minimal create read the product back")
+				}
+				if applied := response.Header().Get("Preference-Applied"); applied != "return=minimal" {
+					t.Errorf("Preference-Applied = %q, want return=minimal", applied)
+				}
+				if response.Body.String() != "{\"id\":1}\n" {
+					t.Errorf("body = %q, want only the id", response.Body.String())
+				}
+				return
+			}
+			if repository.idWrites != 0 {
+				t.Error("full create skipped reading the product back")
+			}
+			if !strings.Contains(response.Body.String(), `"name":`) {
+				t.Errorf("body = %q, want the full product", response.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package productservice
+
+import "net/http"
+
+func (service service) Delete(response http.ResponseWriter, request *http.Request) {
+	id, err := parseIDParam(request, "id")
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	if err := service.usecase.Delete(request.Context(), id); err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	response.WriteHeader(204)
+}
@@ -0,0 +1,41 @@
+package productservice
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (service service) DeleteMany(response http.ResponseWriter, request *http.Request) {
+	ids, err := parseIDs(request.FormValue("ids"))
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	results, err := service.usecase.DeleteMany(request.Context(), ids)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, http.StatusMultiStatus, map[string]any{"results": results})
+}
+
+func parseIDs(value string) ([]int32, error) {
+	ids := []int32{}
+	if value == "" {
+		return ids, nil
+	}
+	for _, field := range strings.Split(value, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(field), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, int32(id))
+	}
+	return ids, nil
+}
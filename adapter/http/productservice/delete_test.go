@@ -0,0 +1,98 @@
+package productservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "deleted", wantStatus: 204},
+		{name: "not found", err: domain.ErrProductNotFound, wantStatus: 404, wantCode: dto.ErrorCodeProductNotFound},
+		{name: "pending schedule", err: fmt.Errorf("%w by product_price_schedules", domain.ErrProductReferenced), wantStatus: 409, wantCode: dto.ErrorCodeProductReferenced},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			harness := testutil.New(testutil.UseCase{
+				DeleteFunc: func(id int32) error {
+					if id != 7 {
+						t.Errorf("id = %d, want 7", id)
+					}
+					return test.err
+				},
+			}, productservice.Options{})
+
+			response := harness.Do("DELETE", "/product/7", "")
+
+			if response.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if test.wantCode == "" {
+				return
+			}
+			errorResponse := dto.ErrorResponse{}
+			if err := json.Unmarshal(response.Body.Bytes(), &errorResponse); err != nil || errorResponse.Code != test.wantCode {
+				t.Errorf("body = %q, want the %s error envelope", response.Body.String(), test.wantCode)
+			}
+		})
+	}
+}
+
+// bulkDeleteRepository reports ids in deleted as deleted and every other id
+// as not found; any other call panics on the nil embedded interface.
+type bulkDeleteRepository struct {
+	domain.ProductRepository
+	deleted map[int32]bool
+}
+
+func (repository bulkDeleteRepository) DeleteMany(ctx context.Context, ids []int32) ([]domain.DeleteResult, error) {
+	results := make([]domain.DeleteResult, 0, len(ids))
+	for _, id := range ids {
+		status := domain.DeleteStatusNotFound
+		if repository.deleted[id] {
+			status = domain.DeleteStatusDeleted
+		}
+		results = append(results, domain.DeleteResult{ID: id, Status: status})
+	}
+	return results, nil
+}
+
+func TestDeleteMany(t *testing.T) {
+	repository := bulkDeleteRepository{deleted: map[int32]bool{1: true, 3: true}}
+	harness := testutil.New(productusecase.New(repository, productusecase.Options{}), productservice.Options{})
+
+	response := harness.Do("DELETE", "/product?ids=1,2,3", "")
+
+	if response.Code != http.StatusMultiStatus {
+		t.Fatalf("status = %d, want %d", response.Code, http.StatusMultiStatus)
+	}
+	body := struct {
+		Results []domain.DeleteResult `json:"results"`
+	}{}
+	if err := json.Unmarshal(response.Body.Bytes(), &body); err != nil {
+		t.Fatalf("body %q: %v", response.Body.String(), err)
+	}
+	want := []domain.DeleteResult{
+		{ID: 1, Status: domain.DeleteStatusDeleted},
+		{ID: 2, Status: domain.DeleteStatusNotFound},
+		{ID: 3, Status: domain.DeleteStatusDeleted},
+	}
+	if !reflect.DeepEqual(body.Results, want) {
+		t.Errorf("results = %+v, want %+v", body.Results, want)
+	}
+}
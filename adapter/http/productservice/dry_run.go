@@ -0,0 +1,25 @@
+package productservice
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func isDryRun(request *http.Request) bool {
+	if dryRun, err := strconv.ParseBool(request.URL.Query().Get("dryRun")); err == nil && dryRun {
+		return true
+	}
+	return hasPreference(request, "dry-run")
+}
+
+func hasPreference(request *http.Request, name string) bool {
+	for _, prefer := range request.Header.Values("Prefer") {
+		for _, preference := range strings.Split(prefer, ",") {
+			if strings.EqualFold(strings.TrimSpace(preference), name) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package productservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+// writeCountingRepository counts the inserts it is asked for, and separately
+// those made through CreateID, which reads nothing back; any other call
+// panics on the nil embedded interface, so a dry run that touches the
+// database fails the test either way.
+type writeCountingRepository struct {
+	domain.ProductRepository
+	writes   int
+	idWrites int
+}
+
+func (repository *writeCountingRepository) Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	repository.writes++
+	return &domain.Product{ID: 1}, nil
+}
+
+func (repository *writeCountingRepository) CreateID(ctx context.Context, productRequest *dto.CreateProductRequest) (int32, error) {
+	repository.writes++
+	repository.idWrites++
+	return 1, nil
+}
+
+func (repository *writeCountingRepository) CreateManyCopy(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error) {
+	repository.writes++
+	return int64(len(productRequests)), nil
+}
+
+func TestDryRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		prefer     string
+		body       string
+		wantStatus int
+		wantNames  []string
+	}{
+		{name: "create", path: "/product?dryRun=true", body: `{"name":"Lamp","price":10}`, wantStatus: 200, wantNames: []string{"Lamp"}},
+		{name: "create with prefer", path: "/product", prefer: "dry-run", body: `{"name":"Lamp","price":10}`, wantStatus: 200, wantNames: []string{"Lamp"}},
+		{name: "invalid create", path: "/product?dryRun=true", body: `{"name":"","price":10}`, wantStatus: 400},
+		{name: "create many", path: "/product/bulk?dryRun=true", body: `[{"name":"Lamp","price":10},{"name":"Desk","price":90}]`, wantStatus: 200, wantNames: []string{"Lamp", "Desk"}},
+		{name: "invalid create many", path: "/product/bulk", prefer: "dry-run", body: `[{"name":"Lamp","price":10},{"name":"Desk","price":-1}]`, wantStatus: 400},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repository := &writeCountingRepository{}
+			harness := testutil.New(productusecase.New(repository, productusecase.Options{}), productservice.Options{})
+			request := httptest.NewRequest("POST", test.path, strings.NewReader(test.body))
+			if test.prefer != "" {
+				request.Header.Set("Prefer", test.prefer)
+			}
+
+			response := harness.DoRequest(request)
+
+			if response.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", response.Code, test.wantStatus, response.Body.String())
+			}
+			if repository.writes != 0 {
+				t.Errorf("repository writes = %d, want none", repository.writes)
+			}
+			if test.wantStatus != 200 {
+				if !strings.Contains(response.Body.String(), `"errors"`) {
+					t.Errorf("body = %s, want the validation errors", response.Body.String())
+				}
+				return
+			}
+			products := []dto.ProductResponse{}
+			var target any = &products
+			if !strings.HasPrefix(test.path, "/product/bulk") {
+				products = append(products, dto.ProductResponse{})
+				target = &products[0]
+			}
+			if err := json.Unmarshal(response.Body.Bytes(), target); err != nil {
+				t.Fatalf("body = %s: %v", response.Body.String(), err)
+			}
+			if len(products) != len(test.wantNames) {
+				t.Fatalf("previewed %d products, want %d", len(products), len(test.wantNames))
+			}
+			for i, product := range products {
+				if product.Name != test.wantNames[i] {
+					t.Errorf("products[%d].name = %q, want %q", i, product.Name, test.wantNames[i])
+				}
+			}
+		})
+	}
+}
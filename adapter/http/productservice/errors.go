@@ -0,0 +1,67 @@
+package productservice
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/adapter/metrics"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gorilla/mux"
+)
+
+const (
+	errorClassValidation   = "validation"
+	errorClassNotFound     = "not_found"
+	errorClassConflict     = "conflict"
+	errorClassPrecondition = "precondition_failed"
+	errorClassTimeout      = "timeout"
+	errorClassInternal     = "internal"
+)
+
+func classifyError(err error) string {
+	var validationError *dto.ValidationError
+	switch {
+	case errors.Is(err, domain.ErrValidation), errors.As(err, &validationError):
+		return errorClassValidation
+	case errors.Is(err, domain.ErrProductNotFound):
+		return errorClassNotFound
+	case errors.Is(err, domain.ErrProductReferenced):
+		return errorClassConflict
+	case errors.Is(err, domain.ErrPreconditionFailed):
+		return errorClassPrecondition
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return errorClassTimeout
+	default:
+		return errorClassInternal
+	}
+}
+
+func writeError(response http.ResponseWriter, request *http.Request, err error) {
+	class := classifyError(err)
+	route := ""
+	if current := mux.CurrentRoute(request); current != nil {
+		route = current.GetName()
+	}
+	metrics.RecordError(route, class)
+
+	var validationError *dto.ValidationError
+	switch {
+	case errors.As(err, &validationError):
+		respond.JSON(response, request, 400, validationError)
+	case class == errorClassValidation:
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+	case class == errorClassNotFound:
+		respond.Error(response, request, 404, dto.ErrorCodeProductNotFound, err.Error())
+	case class == errorClassConflict:
+		respond.Error(response, request, 409, dto.ErrorCodeProductReferenced, err.Error())
+	case class == errorClassPrecondition:
+		respond.Error(response, request, 412, dto.ErrorCodePreconditionFailed, err.Error())
+	case class == errorClassTimeout:
+		respond.Error(response, request, 504, dto.ErrorCodeTimeout, err.Error())
+	default:
+		respond.Error(response, request, 500, dto.ErrorCodeInternal, err.Error())
+	}
+}
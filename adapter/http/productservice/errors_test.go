@@ -0,0 +1,94 @@
+package productservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "validation", err: fmt.Errorf("%w: id must be greater than 0", domain.ErrValidation), wantStatus: 400, wantCode: dto.ErrorCodeBadRequest},
+		{name: "not found", err: domain.ErrProductNotFound, wantStatus: 404, wantCode: dto.ErrorCodeProductNotFound},
+		{name: "conflict", err: domain.ErrProductReferenced, wantStatus: 409, wantCode: dto.ErrorCodeProductReferenced},
+		{name: "timeout", err: context.DeadlineExceeded, wantStatus: 504, wantCode: dto.ErrorCodeTimeout},
+		{name: "internal", err: errors.New("connection refused"), wantStatus: 500, wantCode: dto.ErrorCodeInternal},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := httptest.NewRecorder()
+			writeError(response, httptest.NewRequest("GET", "/product/1", nil), test.err)
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			errorResponse := dto.ErrorResponse{}
+			if err := json.Unmarshal(response.Body.Bytes(), &errorResponse); err != nil {
+				t.Fatalf("body %q is not the error envelope: %v", response.Body.String(), err)
+			}
+			if errorResponse.Code != test.wantCode || errorResponse.Message != test.err.Error() {
+				t.Errorf("error = %+v, want code %s and message %q", errorResponse, test.wantCode, test.err.Error())
+			}
+		})
+	}
+}
+
+// errorCount reads http_errors_total for route and class from the default
+// registry.
+func errorCount(t *testing.T, route string, class string) float64 {
+	t.Helper()
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != "http_errors_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["route"] == route && labels["class"] == class {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func TestWriteErrorCountsByClass(t *testing.T) {
+	router := mux.NewRouter()
+	router.Handle("/product", http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		validationError := &dto.ValidationError{}
+		validationError.Add("price", "gt", "must be greater than 0")
+		writeError(response, request, fmt.Errorf("%w: %w", domain.ErrValidation, validationError))
+	})).Name("createProductMetricsTest")
+	validationBefore := errorCount(t, "createProductMetricsTest", errorClassValidation)
+	internalBefore := errorCount(t, "createProductMetricsTest", errorClassInternal)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/product", nil))
+
+	if got := errorCount(t, "createProductMetricsTest", errorClassValidation) - validationBefore; got != 1 {
+		t.Errorf("validation errors counted = %v, want 1", got)
+	}
+	if got := errorCount(t, "createProductMetricsTest", errorClassInternal) - internalBefore; got != 0 {
+		t.Errorf("internal errors counted = %v, want 0", got)
+	}
+}
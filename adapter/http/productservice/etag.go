@@ -0,0 +1,40 @@
+package productservice
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func productETag(product *dto.ProductResponse) (string, error) {
+	body, err := json.Marshal(product)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`"%x"`, sha256.Sum256(body)), nil
+}
+
+func etagMatches(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// etagMatchesStrong is the comparison If-Match needs: a weak candidate never
+// matches.
+func etagMatchesStrong(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
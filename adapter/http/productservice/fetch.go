@@ -1,27 +1,98 @@
 package productservice
 
 import (
-	"encoding/json"
 	"net/http"
+	"time"
 
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
 	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
 )
 
 func (service service) Fetch(response http.ResponseWriter, request *http.Request) {
 	paginationRequest, err := dto.FromValuePaginationRequestParams(request)
 	if err != nil {
-		response.WriteHeader(500)
-		response.Write([]byte(err.Error()))
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
 		return
 	}
+	request = request.WithContext(dto.ContextWithPricing(request.Context(), paginationRequest.WithPricing))
 
-	products, err := service.usecase.Fetch(paginationRequest)
+	if value := request.FormValue("ids"); value != "" {
+		service.fetchByIDs(response, request, value)
+		return
+	}
+
+	if value := request.FormValue("updatedSince"); value != "" {
+		since, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			respond.Error(response, request, 400, dto.ErrorCodeBadRequest, "invalid updatedSince value: expected an RFC 3339 timestamp")
+			return
+		}
+		service.fetchUpdatedSince(response, request, since, paginationRequest)
+		return
+	}
+
+	// Last-Modified is only an optimization, so failing to compute it must not
+	// keep Fetch from serving the listing, possibly stale, while the database
+	// is unavailable.
+	lastModified, err := service.usecase.FetchLastModified(request.Context(), paginationRequest)
+	if err != nil && classifyError(err) == errorClassValidation {
+		writeError(response, request, err)
+		return
+	}
+	if err != nil {
+		logging.FromContext(request.Context()).Warn("Unable to fetch products last modified", "error", err)
+	} else if lastModified != nil {
+		response.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		if notModifiedSince(request, *lastModified) {
+			response.WriteHeader(304)
+			return
+		}
+	}
+
+	products, err := service.usecase.Fetch(request.Context(), paginationRequest)
 	if err != nil {
-		response.WriteHeader(500)
-		response.Write([]byte(err.Error()))
+		writeError(response, request, err)
 		return
 	}
 
-	json.NewEncoder(response).Encode(products)
+	writeStaleWarning(response, products.Stale)
+	writeTotalCount(response, products.Total)
+	writePaginationLinks(response, request, paginationRequest.Page, paginationRequest.ItemsPerPage, products.Total)
+	respond.JSON(response, request, 200, mapper.ToProductPaginationResponse(products))
+
+}
+
+func (service service) fetchUpdatedSince(response http.ResponseWriter, request *http.Request, since time.Time, paginationRequest *dto.PaginationRequestParams) {
+	products, err := service.usecase.FetchUpdatedSince(request.Context(), since, paginationRequest)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	writeTotalCount(response, products.Total)
+	writePaginationLinks(response, request, paginationRequest.Page, paginationRequest.ItemsPerPage, products.Total)
+	respond.JSON(response, request, 200, mapper.ToProductPaginationResponse(products))
+}
+
+// fetchByIDs lists the requested products in the order of the ids.
+func (service service) fetchByIDs(response http.ResponseWriter, request *http.Request, value string) {
+	ids, err := parseIDs(value)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	products, err := service.usecase.GetByIDs(request.Context(), ids)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
 
+	respond.JSON(response, request, 200, mapper.ToProductPaginationResponse(&domain.Pagination[[]domain.Product]{
+		Items: products,
+		Total: int32(len(products)),
+	}))
 }
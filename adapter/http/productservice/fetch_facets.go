@@ -0,0 +1,17 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+)
+
+func (service service) FetchFacets(response http.ResponseWriter, request *http.Request) {
+	facets, err := service.usecase.FetchFacets(request.Context(), request.FormValue("search"))
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, 200, facets)
+}
@@ -0,0 +1,24 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (service service) FetchIDs(response http.ResponseWriter, request *http.Request) {
+	paginationRequest, err := dto.FromValuePaginationRequestParams(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	ids, err := service.usecase.FetchIDs(request.Context(), paginationRequest)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, 200, dto.ProductIDsResponse{IDs: ids, Total: len(ids)})
+}
@@ -0,0 +1,207 @@
+package productservice_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestFetchLastModified(t *testing.T) {
+	modified := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		ifModifiedSince  string
+		lastModified     *time.Time
+		lastModifiedErr  error
+		wantStatus       int
+		wantLastModified string
+		wantFetched      bool
+	}{
+		{
+			name:             "no condition",
+			lastModified:     &modified,
+			wantStatus:       200,
+			wantLastModified: "Wed, 01 May 2024 12:00:00 GMT",
+			wantFetched:      true,
+		},
+		{
+			name:             "unchanged since",
+			ifModifiedSince:  "Wed, 01 May 2024 12:00:00 GMT",
+			lastModified:     &modified,
+			wantStatus:       304,
+			wantLastModified: "Wed, 01 May 2024 12:00:00 GMT",
+		},
+		{
+			name:             "updated since",
+			ifModifiedSince:  "Wed, 01 May 2024 11:59:59 GMT",
+			lastModified:     &modified,
+			wantStatus:       200,
+			wantLastModified: "Wed, 01 May 2024 12:00:00 GMT",
+			wantFetched:      true,
+		},
+		{
+			name:            "last modified unavailable",
+			ifModifiedSince: "Wed, 01 May 2024 12:00:00 GMT",
+			lastModifiedErr: errors.New("connection refused"),
+			wantStatus:      200,
+			wantFetched:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fetched := false
+			harness := testutil.New(testutil.UseCase{
+				FetchLastModifiedFunc: func(*dto.PaginationRequestParams) (*time.Time, error) {
+					return test.lastModified, test.lastModifiedErr
+				},
+				FetchFunc: func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+					fetched = true
+					return &domain.Pagination[[]domain.Product]{Items: []domain.Product{{ID: 1}}, Total: 1}, nil
+				},
+			}, productservice.Options{})
+			request := httptest.NewRequest(http.MethodGet, "/product", nil)
+			if test.ifModifiedSince != "" {
+				request.Header.Set("If-Modified-Since", test.ifModifiedSince)
+			}
+			response := harness.DoRequest(request)
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if got := response.Header().Get("Last-Modified"); got != test.wantLastModified {
+				t.Errorf("Last-Modified = %q, want %q", got, test.wantLastModified)
+			}
+			if fetched != test.wantFetched {
+				t.Errorf("fetched = %v, want %v", fetched, test.wantFetched)
+			}
+		})
+	}
+}
+
+func TestFetchUpdatedSinceSetsListingHeaders(t *testing.T) {
+	since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	harness := testutil.New(testutil.UseCase{
+		FetchUpdatedSinceFunc: func(got time.Time, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+			if !got.Equal(since) {
+				t.Errorf("since = %v, want %v", got, since)
+			}
+			return &domain.Pagination[[]domain.Product]{Items: []domain.Product{{ID: 1}, {ID: 2, Deleted: true}}, Total: 25}, nil
+		},
+	}, productservice.Options{})
+	response := harness.Do(http.MethodGet, "/product?updatedSince=2024-05-01T00:00:00Z", "")
+
+	if response.Code != 200 {
+		t.Fatalf("status = %d, want 200", response.Code)
+	}
+	if got := response.Header().Get(productservice.TotalCountHeader); got != "25" {
+		t.Errorf("%s = %q, want 25", productservice.TotalCountHeader, got)
+	}
+	if response.Header().Get("Link") == "" {
+		t.Error("Link header is missing")
+	}
+}
+
+// BenchmarkFetchEncoding measures writing a page of products as JSON.
+func BenchmarkFetchEncoding(b *testing.B) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	for _, size := range []int{dto.MaxItemsPerPage, 1000} {
+		products := make([]domain.Product, size)
+		for i := range products {
+			products[i] = domain.Product{ID: int32(i + 1), Name: "Chair", Price: 10, Description: "Oak chair", UpdatedAt: updatedAt}
+		}
+		harness := testutil.New(testutil.UseCase{
+			FetchFunc: func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+				return &domain.Pagination[[]domain.Product]{Items: products, Total: int32(size)}, nil
+			},
+		}, productservice.Options{})
+
+		b.Run(fmt.Sprintf("products=%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				response := harness.Do(http.MethodGet, "/product?itemsPerPage=100", "")
+				if response.Code != 200 {
+					b.Fatalf("status = %d, want 200", response.Code)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchPaginationForms(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		wantStatus       int
+		wantPage         int
+		wantItemsPerPage int
+	}{
+		{name: "page based", query: "?page=2&itemsPerPage=5", wantStatus: 200, wantPage: 2, wantItemsPerPage: 5},
+		{name: "offset based", query: "?offset=5&limit=5", wantStatus: 200, wantPage: 2, wantItemsPerPage: 5},
+		{name: "both forms", query: "?page=2&offset=5&limit=5", wantStatus: 400},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var fetched *dto.PaginationRequestParams
+			harness := testutil.New(testutil.UseCase{
+				FetchFunc: func(pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+					fetched = pagination
+					return &domain.Pagination[[]domain.Product]{Items: []domain.Product{}}, nil
+				},
+			}, productservice.Options{})
+
+			response := harness.Do("GET", "/product"+test.query, "")
+
+			if response.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if test.wantStatus != 200 {
+				if fetched != nil {
+					t.Errorf("fetched with %+v, want no fetch", fetched)
+				}
+				return
+			}
+			if fetched.Page != test.wantPage || fetched.ItemsPerPage != test.wantItemsPerPage {
+				t.Errorf("fetched page %d of %d items, want page %d of %d", fetched.Page, fetched.ItemsPerPage, test.wantPage, test.wantItemsPerPage)
+			}
+		})
+	}
+}
+
+func TestFetchRejectsInvalidDescending(t *testing.T) {
+	harness := testutil.New(testutil.UseCase{}, productservice.Options{})
+
+	response := harness.Do("GET", "/product?sort=name&descending=yes", "")
+
+	if response.Code != 400 {
+		t.Errorf("status = %d, want 400", response.Code)
+	}
+}
+
+func TestFetchLinksKeepFilters(t *testing.T) {
+	harness := testutil.New(testutil.UseCase{
+		FetchFunc: func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+			return &domain.Pagination[[]domain.Product]{Items: []domain.Product{{ID: 6}}, Total: 20}, nil
+		},
+	}, productservice.Options{})
+
+	response := harness.Do("GET", "/product?search=oak&sort=price&page=2&itemsPerPage=5", "")
+
+	if response.Code != 200 {
+		t.Fatalf("status = %d, want 200", response.Code)
+	}
+	want := `</product?itemsPerPage=5&page=1&search=oak&sort=price>; rel="first", ` +
+		`</product?itemsPerPage=5&page=1&search=oak&sort=price>; rel="prev", ` +
+		`</product?itemsPerPage=5&page=3&search=oak&sort=price>; rel="next", ` +
+		`</product?itemsPerPage=5&page=4&search=oak&sort=price>; rel="last"`
+	if link := response.Header().Get("Link"); link != want {
+		t.Errorf("Link = %q, want %q", link, want)
+	}
+}
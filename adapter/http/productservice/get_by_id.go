@@ -0,0 +1,45 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
+)
+
+func (service service) GetByID(response http.ResponseWriter, request *http.Request) {
+	request, err := withPricing(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	id, err := parseIDParam(request, "id")
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	product, err := service.usecase.GetByID(request.Context(), id)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	productResponse := mapper.ToProductResponse(product)
+	etag, err := productETag(&productResponse)
+	if err != nil {
+		respond.Error(response, request, 500, dto.ErrorCodeInternal, err.Error())
+		return
+	}
+	response.Header().Set("ETag", etag)
+	writeStaleWarning(response, product.Stale)
+
+	if ifNoneMatch := request.Header.Get("If-None-Match"); ifNoneMatch != "" && etagMatches(ifNoneMatch, etag) {
+		response.WriteHeader(304)
+		return
+	}
+
+	respond.JSON(response, request, 200, productResponse)
+}
@@ -0,0 +1,64 @@
+package productservice_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func TestGetByIDConditional(t *testing.T) {
+	product := &domain.Product{ID: 7, Name: "Lamp", Price: 10}
+	useCase := func(stale bool) testutil.UseCase {
+		return testutil.UseCase{GetByIDFunc: func(int32) (*domain.Product, error) {
+			found := *product
+			found.Stale = stale
+			return &found, nil
+		}}
+	}
+	etag := testutil.New(useCase(false), productservice.Options{}).Do("GET", "/product/7", "").Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("GET answered without an ETag")
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		stale       bool
+		wantStatus  int
+		wantWarning bool
+	}{
+		{name: "unconditional", wantStatus: 200},
+		{name: "matching etag", ifNoneMatch: etag, wantStatus: 304},
+		{name: "weak matching etag", ifNoneMatch: "W/" + etag, wantStatus: 304},
+		{name: "one of several", ifNoneMatch: `"other", ` + etag, wantStatus: 304},
+		{name: "changed", ifNoneMatch: `"other"`, wantStatus: 200},
+		{name: "stale", stale: true, wantStatus: 200, wantWarning: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			harness := testutil.New(useCase(test.stale), productservice.Options{})
+
+			request := httptest.NewRequest("GET", "/product/7", nil)
+			if test.ifNoneMatch != "" {
+				request.Header.Set("If-None-Match", test.ifNoneMatch)
+			}
+			response := harness.DoRequest(request)
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if value := response.Header().Get("ETag"); value != etag {
+				t.Errorf("ETag = %q, want %q", value, etag)
+			}
+			if test.wantStatus == 304 && response.Body.Len() > 0 {
+				t.Errorf("body = %q, want none on 304", response.Body.String())
+			}
+			if hasWarning := response.Header().Get("Warning") != ""; hasWarning != test.wantWarning {
+				t.Errorf("Warning = %q, want present = %v", response.Header().Get("Warning"), test.wantWarning)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+)
+
+func (service service) GetPriceHistory(response http.ResponseWriter, request *http.Request) {
+	id, err := parseIDParam(request, "id")
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	history, err := service.usecase.GetPriceHistory(request.Context(), id)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, 200, history)
+}
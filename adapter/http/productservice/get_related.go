@@ -0,0 +1,46 @@
+package productservice
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
+)
+
+const (
+	defaultRelatedLimit = 5
+	maxRelatedLimit     = 50
+)
+
+func (service service) GetRelated(response http.ResponseWriter, request *http.Request) {
+	request, err := withPricing(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	id, err := parseIDParam(request, "id")
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	limit := defaultRelatedLimit
+	if value := request.FormValue("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxRelatedLimit {
+			respond.Error(response, request, 400, dto.ErrorCodeBadRequest, "limit must be between 1 and "+strconv.Itoa(maxRelatedLimit))
+			return
+		}
+	}
+
+	products, err := service.usecase.GetRelated(request.Context(), id, limit)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, 200, mapper.ToProductResponses(products))
+}
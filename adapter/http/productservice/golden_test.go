@@ -0,0 +1,69 @@
+package productservice_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+var update = flag.Bool("update", false, "rewrite the testdata/*.golden.json files from the handlers' output")
+
+// TestGoldenResponses locks down the wire format of the responses, field
+// order and omitted fields included. Run go test -update after an intended
+// contract change and review the diff of testdata.
+func TestGoldenResponses(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	startsAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	products := []domain.Product{
+		{ID: 4, Name: "Lamp", Price: 10, Description: "Desk lamp", UpdatedAt: updatedAt},
+		{ID: 5, Name: "Chair", Price: 25.5, Description: "Oak chair", Discount: &domain.Discount{Type: domain.DiscountTypePercentage, Value: 10, StartsAt: &startsAt}, UpdatedAt: updatedAt},
+	}
+	harness := testutil.New(testutil.UseCase{
+		CreateFunc: func(productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+			return &domain.Product{ID: 12, Name: productRequest.Name, Price: productRequest.Price, Description: productRequest.Description, UpdatedAt: updatedAt}, nil
+		},
+		FetchFunc: func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+			return &domain.Pagination[[]domain.Product]{Items: products, Total: 7, CountStrategy: domain.CountStrategyExact}, nil
+		},
+	}, productservice.Options{})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{name: "create", method: "POST", path: "/product", body: `{"name":"Lamp","price":10,"description":"Desk lamp"}`},
+		{name: "fetch", method: "GET", path: "/product?page=2&itemsPerPage=2&sort=price"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := harness.Do(test.method, test.path, test.body)
+			if response.Code >= 300 {
+				t.Fatalf("status = %d (body %q)", response.Code, response.Body.String())
+			}
+
+			golden := filepath.Join("testdata", test.name+".golden.json")
+			if *update {
+				if err := os.WriteFile(golden, response.Body.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v; run go test -update to create it", err)
+			}
+			if !bytes.Equal(response.Body.Bytes(), want) {
+				t.Errorf("body differs from %s:\n got %s\nwant %s", golden, response.Body.Bytes(), want)
+			}
+		})
+	}
+}
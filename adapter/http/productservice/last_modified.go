@@ -0,0 +1,20 @@
+package productservice
+
+import (
+	"net/http"
+	"time"
+)
+
+// notModifiedSince reports whether If-Modified-Since is at or after
+// lastModified, compared at the one-second resolution of HTTP dates.
+func notModifiedSince(request *http.Request, lastModified time.Time) bool {
+	header := request.Header.Get("If-Modified-Since")
+	if header == "" || request.Header.Get("If-None-Match") != "" {
+		return false
+	}
+	since, err := http.ParseTime(header)
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(since)
+}
@@ -0,0 +1,49 @@
+package productservice
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// buildPageURL links to page of the same listing, keeping every other query
+// parameter. A listing requested by offset and limit is linked the same way.
+func buildPageURL(request *http.Request, page int) string {
+	query := request.URL.Query()
+	if query.Has("offset") || query.Has("limit") {
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit < 1 {
+			limit = dto.DefaultItemsPerPage
+		}
+		query.Set("offset", strconv.Itoa((page-1)*limit))
+	} else {
+		query.Set("page", strconv.Itoa(page))
+	}
+	return request.URL.Path + "?" + query.Encode()
+}
+
+func writePaginationLinks(response http.ResponseWriter, request *http.Request, page int, itemsPerPage int, total int32) {
+	if itemsPerPage < 1 {
+		return
+	}
+	lastPage := (int(total) + itemsPerPage - 1) / itemsPerPage
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	links := []string{
+		fmt.Sprintf(`<%s>; rel="first"`, buildPageURL(request, 1)),
+	}
+	if page > 1 {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, buildPageURL(request, page-1)))
+	}
+	if page < lastPage {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, buildPageURL(request, page+1)))
+	}
+	links = append(links, fmt.Sprintf(`<%s>; rel="last"`, buildPageURL(request, lastPage)))
+
+	response.Header().Set("Link", strings.Join(links, ", "))
+}
@@ -0,0 +1,55 @@
+package productservice
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestBuildPageURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		page   int
+		want   url.Values
+	}{
+		{
+			name:   "filtered search",
+			target: "/product?search=oak&sort=price,name&descending=true&page=2&itemsPerPage=5",
+			page:   3,
+			want:   url.Values{"search": {"oak"}, "sort": {"price,name"}, "descending": {"true"}, "page": {"3"}, "itemsPerPage": {"5"}},
+		},
+		{
+			name:   "first request without a page",
+			target: "/product?search=oak",
+			page:   2,
+			want:   url.Values{"search": {"oak"}, "page": {"2"}},
+		},
+		{
+			name:   "offset and limit",
+			target: "/product?search=oak&offset=5&limit=5",
+			page:   3,
+			want:   url.Values{"search": {"oak"}, "offset": {"10"}, "limit": {"5"}},
+		},
+		{
+			name:   "offset with the default limit",
+			target: "/product?offset=10",
+			page:   1,
+			want:   url.Values{"offset": {"0"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			link, err := url.Parse(buildPageURL(httptest.NewRequest("GET", test.target, nil), test.page))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if link.Path != "/product" {
+				t.Errorf("path = %q, want /product", link.Path)
+			}
+			if got := link.Query(); got.Encode() != test.want.Encode() {
+				t.Errorf("query = %q, want %q", got.Encode(), test.want.Encode())
+			}
+		})
+	}
+}
@@ -1,13 +1,24 @@
 package productservice
 
-import "github.com/gabriwl165/clean-arch-go/core/domain"
+import (
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+type Options struct {
+	StrictDecoding bool
+	JSONLimits     dto.JSONLimits
+	Validation     dto.ValidationRules
+}
 
 type service struct {
 	usecase domain.ProductUseCase
+	options Options
 }
 
-func New(usecase domain.ProductUseCase) domain.ProductService {
+func New(usecase domain.ProductUseCase, options Options) domain.ProductService {
 	return &service{
 		usecase: usecase,
+		options: options,
 	}
 }
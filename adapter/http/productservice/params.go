@@ -0,0 +1,22 @@
+package productservice
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gorilla/mux"
+)
+
+// parseIDParam reads the named path parameter as a product id. Anything but
+// a positive int32 is a validation error, so handlers answer it with 400.
+func parseIDParam(request *http.Request, name string) (int32, error) {
+	value := mux.Vars(request)[name]
+	id, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || id < 1 {
+		return 0, fmt.Errorf("%w: %s must be an integer between 1 and %d, got %q", domain.ErrValidation, name, math.MaxInt32, value)
+	}
+	return int32(id), nil
+}
@@ -0,0 +1,49 @@
+package productservice
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func TestParseIDParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int32
+		wantErr bool
+	}{
+		{name: "valid", value: "42", want: 42},
+		{name: "largest", value: "2147483647", want: 2147483647},
+		{name: "non-numeric", value: "abc", wantErr: true},
+		{name: "zero", value: "0", wantErr: true},
+		{name: "negative", value: "-3", wantErr: true},
+		{name: "overflow", value: "2147483648", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := mux.SetURLVars(httptest.NewRequest("GET", "/product/"+test.value, nil), map[string]string{"id": test.value})
+
+			id, err := parseIDParam(request, "id")
+			if !test.wantErr {
+				if err != nil || id != test.want {
+					t.Errorf("parseIDParam(%q) = %d, %v, want %d", test.value, id, err, test.want)
+				}
+				return
+			}
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Fatalf("parseIDParam(%q) error = %v, want %v", test.value, err, domain.ErrValidation)
+			}
+			response := httptest.NewRecorder()
+			writeError(response, request, err)
+			if response.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", response.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// withPricing records ?withPricing in the request context, where the use
+// cases look for it. On error the request is returned unchanged.
+func withPricing(request *http.Request) (*http.Request, error) {
+	pricing, err := dto.FromValueWithPricing(request)
+	if err != nil {
+		return request, err
+	}
+	return request.WithContext(dto.ContextWithPricing(request.Context(), pricing)), nil
+}
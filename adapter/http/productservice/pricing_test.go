@@ -0,0 +1,56 @@
+package productservice_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+// productRepository answers GetByID with product; any other call panics on
+// the nil embedded interface.
+type productRepository struct {
+	domain.ProductRepository
+	product domain.Product
+}
+
+func (repository productRepository) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	product := repository.product
+	return &product, nil
+}
+
+func TestGetByIDWithPricing(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		wantStatus       int
+		wantEffectiveSet bool
+	}{
+		{name: "not requested", wantStatus: 200},
+		{name: "requested", query: "?withPricing=true", wantStatus: 200, wantEffectiveSet: true},
+		{name: "invalid", query: "?withPricing=maybe", wantStatus: 400},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repository := productRepository{product: domain.Product{ID: 7, Name: "Lamp", Price: 100, Discount: &domain.Discount{Type: domain.DiscountTypeFixed, Value: 10}}}
+			harness := testutil.New(productusecase.New(repository, productusecase.Options{}), productservice.Options{})
+
+			response := harness.Do("GET", "/product/7"+test.query, "")
+
+			if response.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if test.wantStatus != 200 {
+				return
+			}
+			hasEffective := strings.Contains(response.Body.String(), `"effectivePrice":90`)
+			if hasEffective != test.wantEffectiveSet {
+				t.Errorf("body = %s, want effectivePrice present = %v", response.Body.String(), test.wantEffectiveSet)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/features"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers every product route on router, named for the
+// per-route timeouts and metrics.
+func RegisterRoutes(router *mux.Router, productService domain.ProductService) {
+	router.Handle("/product", http.HandlerFunc(productService.Create)).Methods("POST").Name("createProduct")
+	router.Handle("/product/bulk", http.HandlerFunc(productService.CreateMany)).Methods("POST").Name("createProducts")
+	router.Handle("/product", http.HandlerFunc(productService.Fetch)).Methods("GET").Name("fetchProducts")
+	router.Handle("/product", http.HandlerFunc(productService.Count)).Methods("HEAD").Name("countProducts")
+	router.Handle("/product", http.HandlerFunc(productService.DeleteMany)).Methods("DELETE").Name("deleteProducts")
+	router.Handle("/product/search", http.HandlerFunc(productService.Search)).Methods("POST").Name("searchProducts")
+	router.Handle("/product/adjust-price", http.HandlerFunc(productService.AdjustPrices)).Methods("POST").Name("adjustProductPrices")
+	router.Handle("/product/batch", http.HandlerFunc(productService.Batch)).Methods("POST").Name("batchProducts")
+	router.Handle("/product/schema", http.HandlerFunc(productService.Schema)).Methods("GET").Name("getProductSchema")
+	router.Handle("/product/facets", features.Gate("facets", http.HandlerFunc(productService.FetchFacets))).Methods("GET").Name("fetchProductFacets")
+	router.Handle("/product/ids", features.Gate("productIds", http.HandlerFunc(productService.FetchIDs))).Methods("GET").Name("fetchProductIDs")
+	router.Handle("/product/{id}", http.HandlerFunc(productService.GetByID)).Methods("GET").Name("getProduct")
+	router.Handle("/product/{id}", http.HandlerFunc(productService.Upsert)).Methods("PUT").Name("upsertProduct")
+	router.Handle("/product/{id}", http.HandlerFunc(productService.Delete)).Methods("DELETE").Name("deleteProduct")
+	router.Handle("/product/{id}/related", http.HandlerFunc(productService.GetRelated)).Methods("GET").Name("getRelatedProducts")
+	router.Handle("/product/{id}/price-schedule", http.HandlerFunc(productService.SchedulePrice)).Methods("POST").Name("scheduleProductPrice")
+	router.Handle("/product/{id}/price-history", http.HandlerFunc(productService.GetPriceHistory)).Methods("GET").Name("getProductPriceHistory")
+}
@@ -0,0 +1,30 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (service service) SchedulePrice(response http.ResponseWriter, request *http.Request) {
+	id, err := parseIDParam(request, "id")
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	schedulePriceRequest, err := dto.FromJSONSchedulePriceRequest(request.Body, service.isStrict(request))
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	schedule, err := service.usecase.SchedulePrice(request.Context(), id, schedulePriceRequest)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, 201, schedule)
+}
@@ -0,0 +1,57 @@
+package productservice_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+// scheduleRepository stores price schedules; any other call panics on the
+// nil embedded interface.
+type scheduleRepository struct {
+	domain.ProductRepository
+	scheduled []dto.SchedulePriceRequest
+}
+
+func (repository *scheduleRepository) SchedulePrice(ctx context.Context, productID int32, schedulePriceRequest *dto.SchedulePriceRequest) (*domain.PriceSchedule, error) {
+	repository.scheduled = append(repository.scheduled, *schedulePriceRequest)
+	return &domain.PriceSchedule{ID: 1, ProductID: productID, NewPrice: schedulePriceRequest.NewPrice, EffectiveAt: schedulePriceRequest.EffectiveAt}, nil
+}
+
+func TestSchedulePrice(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantField  string
+	}{
+		{name: "scheduled", body: `{"newPrice":9.5,"effectiveAt":"2024-06-01T00:00:00Z"}`, wantStatus: 201},
+		{name: "missing body", wantStatus: 400, wantField: "newPrice"},
+		{name: "zero price", body: `{"newPrice":0,"effectiveAt":"2024-06-01T00:00:00Z"}`, wantStatus: 400, wantField: "newPrice"},
+		{name: "missing effectiveAt", body: `{"newPrice":9.5}`, wantStatus: 400, wantField: "effectiveAt"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repository := &scheduleRepository{}
+			harness := testutil.New(productusecase.New(repository, productusecase.Options{}), productservice.Options{})
+
+			response := harness.Do("POST", "/product/7/price-schedule", test.body)
+
+			if response.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d (body %q)", response.Code, test.wantStatus, response.Body.String())
+			}
+			if test.wantField != "" && !strings.Contains(response.Body.String(), test.wantField) {
+				t.Errorf("body = %q, want it to name %s", response.Body.String(), test.wantField)
+			}
+			if scheduled := len(repository.scheduled) == 1; scheduled != (test.wantStatus == 201) {
+				t.Errorf("scheduled = %v", repository.scheduled)
+			}
+		})
+	}
+}
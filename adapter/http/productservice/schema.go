@@ -0,0 +1,24 @@
+package productservice
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// Schema serves the create request schema as application/schema+json, which
+// also keeps the JSON naming policy from renaming its properties: they name
+// request fields, which are always camelCase.
+func (service service) Schema(response http.ResponseWriter, request *http.Request) {
+	body, err := json.Marshal(dto.CreateProductRequestSchema(service.options.Validation))
+	if err != nil {
+		respond.Error(response, request, 500, dto.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/schema+json")
+	response.WriteHeader(200)
+	response.Write(body)
+}
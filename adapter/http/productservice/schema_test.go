@@ -0,0 +1,48 @@
+package productservice_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestSchema(t *testing.T) {
+	harness := testutil.New(testutil.UseCase{}, productservice.Options{Validation: dto.ValidationRules{MinPrice: 0.5}})
+
+	response := harness.Do("GET", "/product/schema", "")
+	if response.Code != 200 {
+		t.Fatalf("status = %d, want 200", response.Code)
+	}
+	if contentType := response.Header().Get("Content-Type"); contentType != "application/schema+json" {
+		t.Errorf("Content-Type = %q, want application/schema+json", contentType)
+	}
+	schema := struct {
+		Required   []string `json:"required"`
+		Properties map[string]struct {
+			Type             any      `json:"type"`
+			Minimum          *float64 `json:"minimum"`
+			ExclusiveMinimum *float64 `json:"exclusiveMinimum"`
+		} `json:"properties"`
+	}{}
+	if err := json.Unmarshal(response.Body.Bytes(), &schema); err != nil {
+		t.Fatalf("body %q: %v", response.Body.String(), err)
+	}
+
+	if !slices.Contains(schema.Required, "name") {
+		t.Errorf("required = %v, want name among them", schema.Required)
+	}
+	price := schema.Properties["price"]
+	if price.Type != "number" {
+		t.Errorf("price type = %v, want number", price.Type)
+	}
+	if price.ExclusiveMinimum == nil || *price.ExclusiveMinimum != 0 {
+		t.Errorf("price exclusiveMinimum = %v, want 0", price.ExclusiveMinimum)
+	}
+	if price.Minimum == nil || *price.Minimum != 0.5 {
+		t.Errorf("price minimum = %v, want the configured 0.5", price.Minimum)
+	}
+}
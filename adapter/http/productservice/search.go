@@ -0,0 +1,31 @@
+package productservice
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
+)
+
+func (service service) Search(response http.ResponseWriter, request *http.Request) {
+	request, err := withPricing(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	searchRequest, err := dto.FromJSONProductSearchRequest(request.Body, service.isStrict(request), service.options.JSONLimits)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	products, err := service.usecase.Search(request.Context(), searchRequest)
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	respond.JSON(response, request, 200, mapper.ToProductPaginationResponse(products))
+}
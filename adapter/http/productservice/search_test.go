@@ -0,0 +1,58 @@
+package productservice_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestSearchDecoding(t *testing.T) {
+	tests := []struct {
+		name         string
+		prefer       string
+		options      productservice.Options
+		wantStatus   int
+		wantSearched bool
+	}{
+		{name: "lenient ignores unknown fields", wantStatus: 200, wantSearched: true},
+		{name: "strict preference rejects unknown fields", prefer: "strict", wantStatus: 400},
+		{name: "strict option rejects unknown fields", options: productservice.Options{StrictDecoding: true}, wantStatus: 400},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			searched := false
+			harness := testutil.New(testutil.UseCase{
+				SearchFunc: func(*dto.ProductSearchRequest) (*domain.Pagination[[]domain.Product], error) {
+					searched = true
+					return &domain.Pagination[[]domain.Product]{Items: []domain.Product{}}, nil
+				},
+			}, test.options)
+
+			request := httptest.NewRequest("POST", "/product/search", strings.NewReader(`{"nameContains":"lamp","nameContain":"lamp"}`))
+			if test.prefer != "" {
+				request.Header.Set("Prefer", test.prefer)
+			}
+			response := harness.DoRequest(request)
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if searched != test.wantSearched {
+				t.Errorf("searched = %v, want %v", searched, test.wantSearched)
+			}
+			if test.wantStatus == 400 {
+				errorResponse := dto.ErrorResponse{}
+				if err := json.Unmarshal(response.Body.Bytes(), &errorResponse); err != nil || !strings.Contains(errorResponse.Message, `"nameContain"`) {
+					t.Errorf("body = %q, want an error naming the unknown field", response.Body.String())
+				}
+			}
+		})
+	}
+}
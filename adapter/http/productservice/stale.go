@@ -0,0 +1,11 @@
+package productservice
+
+import "net/http"
+
+const staleWarning = `110 - "Response is Stale"`
+
+func writeStaleWarning(response http.ResponseWriter, stale bool) {
+	if stale {
+		response.Header().Add("Warning", staleWarning)
+	}
+}
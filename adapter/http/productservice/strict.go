@@ -0,0 +1,9 @@
+package productservice
+
+import "net/http"
+
+// isStrict reports whether unknown JSON fields should be rejected, either
+// because strict decoding is configured or the client sent "Prefer: strict".
+func (service service) isStrict(request *http.Request) bool {
+	return service.options.StrictDecoding || hasPreference(request, "strict")
+}
@@ -0,0 +1,45 @@
+// Package testutil serves the product handlers for tests.
+package testutil
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gorilla/mux"
+)
+
+// Harness routes requests to a product service the way the server does, so
+// handlers see their path variables and route names.
+type Harness struct {
+	Router *mux.Router
+}
+
+// New serves a product service backed by usecase, which is usually a
+// UseCase or a real use case over a fake repository.
+func New(usecase domain.ProductUseCase, options productservice.Options) *Harness {
+	router := mux.NewRouter()
+	productservice.RegisterRoutes(router, productservice.New(usecase, options))
+	return &Harness{Router: router}
+}
+
+// Do sends a request with body, which may be empty, and returns the recorded
+// response.
+func (harness *Harness) Do(method string, path string, body string) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	return harness.DoRequest(httptest.NewRequest(method, path, reader))
+}
+
+// DoRequest sends request, for tests that need to set headers, and returns
+// the recorded response.
+func (harness *Harness) DoRequest(request *http.Request) *httptest.ResponseRecorder {
+	response := httptest.NewRecorder()
+	harness.Router.ServeHTTP(response, request)
+	return response
+}
@@ -0,0 +1,70 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// UseCase answers the calls a test sets a function for; any other call
+// panics on the nil embedded interface.
+type UseCase struct {
+	domain.ProductUseCase
+	FetchFunc              func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error)
+	CountFunc              func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error)
+	FetchLastModifiedFunc  func(*dto.PaginationRequestParams) (*time.Time, error)
+	FetchUpdatedSinceFunc  func(time.Time, *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error)
+	SearchFunc             func(*dto.ProductSearchRequest) (*domain.Pagination[[]domain.Product], error)
+	CreateFunc             func(*dto.CreateProductRequest) (*domain.Product, error)
+	GetByIDFunc            func(int32) (*domain.Product, error)
+	UpsertFunc             func(int32, *dto.CreateProductRequest) (*domain.Product, bool, error)
+	UpdateIfUnmodifiedFunc func(int32, time.Time, *dto.CreateProductRequest) (*domain.Product, error)
+	DeleteFunc             func(int32) error
+}
+
+func (usecase UseCase) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	return usecase.FetchFunc(pagination)
+}
+
+func (usecase UseCase) Count(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	return usecase.CountFunc(pagination)
+}
+
+// FetchLastModified reports no modification time when the test sets no
+// function, so Fetch tests only set the one they assert on.
+func (usecase UseCase) FetchLastModified(ctx context.Context, pagination *dto.PaginationRequestParams) (*time.Time, error) {
+	if usecase.FetchLastModifiedFunc == nil {
+		return nil, nil
+	}
+	return usecase.FetchLastModifiedFunc(pagination)
+}
+
+func (usecase UseCase) FetchUpdatedSince(ctx context.Context, since time.Time, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	return usecase.FetchUpdatedSinceFunc(since, pagination)
+}
+
+func (usecase UseCase) Search(ctx context.Context, searchRequest *dto.ProductSearchRequest) (*domain.Pagination[[]domain.Product], error) {
+	return usecase.SearchFunc(searchRequest)
+}
+
+func (usecase UseCase) Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	return usecase.CreateFunc(productRequest)
+}
+
+func (usecase UseCase) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	return usecase.GetByIDFunc(id)
+}
+
+func (usecase UseCase) Upsert(ctx context.Context, id int32, productRequest *dto.CreateProductRequest) (*domain.Product, bool, error) {
+	return usecase.UpsertFunc(id, productRequest)
+}
+
+func (usecase UseCase) UpdateIfUnmodified(ctx context.Context, id int32, updatedAt time.Time, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	return usecase.UpdateIfUnmodifiedFunc(id, updatedAt, productRequest)
+}
+
+func (usecase UseCase) Delete(ctx context.Context, id int32) error {
+	return usecase.DeleteFunc(id)
+}
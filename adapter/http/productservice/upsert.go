@@ -0,0 +1,92 @@
+package productservice
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/respond"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
+)
+
+func (service service) Upsert(response http.ResponseWriter, request *http.Request) {
+	request, err := withPricing(request)
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	id, err := parseIDParam(request, "id")
+	if err != nil {
+		writeError(response, request, err)
+		return
+	}
+
+	productRequest, err := dto.FromJSONCreateProductRequest(request.Body, service.isStrict(request))
+	if err != nil {
+		respond.Error(response, request, 400, dto.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	var product *domain.Product
+	created := false
+	if ifMatch := request.Header.Get("If-Match"); ifMatch != "" {
+		// The match is checked against the product as read here, and the
+		// write only applies while the product is still at that version.
+		current, err := service.currentMatching(request, id, ifMatch)
+		if err != nil {
+			writeError(response, request, err)
+			return
+		}
+		if current == nil {
+			respond.Error(response, request, 412, dto.ErrorCodePreconditionFailed, "If-Match does not match the current product")
+			return
+		}
+		product, err = service.usecase.UpdateIfUnmodified(request.Context(), id, current.UpdatedAt, productRequest)
+		if err != nil {
+			writeError(response, request, err)
+			return
+		}
+	} else {
+		product, created, err = service.usecase.Upsert(request.Context(), id, productRequest)
+		if err != nil {
+			writeError(response, request, err)
+			return
+		}
+	}
+
+	productResponse := mapper.ToProductResponse(product)
+	if etag, err := productETag(&productResponse); err == nil {
+		response.Header().Set("ETag", etag)
+	}
+	status := 200
+	if created {
+		response.Header().Set("Location", request.URL.Path)
+		status = 201
+	}
+	respond.JSON(response, request, status, productResponse)
+}
+
+// currentMatching returns the product as it is now when ifMatch matches
+// the ETag GET returns for it, and nil otherwise. An absent product matches
+// nothing, not even "*".
+func (service service) currentMatching(request *http.Request, id int32, ifMatch string) (*domain.Product, error) {
+	current, err := service.usecase.GetByID(request.Context(), id)
+	if errors.Is(err, domain.ErrProductNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	currentResponse := mapper.ToProductResponse(current)
+	etag, err := productETag(&currentResponse)
+	if err != nil {
+		return nil, err
+	}
+	if !etagMatchesStrong(ifMatch, etag) {
+		return nil, nil
+	}
+	return current, nil
+}
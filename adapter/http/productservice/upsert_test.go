@@ -0,0 +1,88 @@
+package productservice_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestUpsert(t *testing.T) {
+	current := &domain.Product{ID: 7, Name: "Lamp", Price: 10, UpdatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	currentETag := testutil.New(testutil.UseCase{GetByIDFunc: func(int32) (*domain.Product, error) {
+		return current, nil
+	}}, productservice.Options{}).Do("GET", "/product/7", "").Header().Get("ETag")
+	if currentETag == "" {
+		t.Fatal("GET answered without an ETag")
+	}
+
+	tests := []struct {
+		name         string
+		existing     *domain.Product
+		ifMatch      string
+		modified     bool
+		wantStatus   int
+		wantUpserted bool
+		wantUpdated  bool
+	}{
+		{name: "update", existing: current, wantStatus: 200, wantUpserted: true},
+		{name: "create", wantStatus: 201, wantUpserted: true},
+		{name: "matching etag", existing: current, ifMatch: currentETag, wantStatus: 200, wantUpdated: true},
+		{name: "weak matching etag", existing: current, ifMatch: "W/" + currentETag, wantStatus: 412},
+		{name: "any etag", existing: current, ifMatch: "*", wantStatus: 200, wantUpdated: true},
+		{name: "stale etag", existing: current, ifMatch: `"stale"`, wantStatus: 412},
+		{name: "etag for absent product", ifMatch: "*", wantStatus: 412},
+		{name: "modified after the etag matched", existing: current, ifMatch: currentETag, modified: true, wantStatus: 412, wantUpdated: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			upserted, updated := false, false
+			harness := testutil.New(testutil.UseCase{
+				GetByIDFunc: func(int32) (*domain.Product, error) {
+					if test.existing == nil {
+						return nil, domain.ErrProductNotFound
+					}
+					return test.existing, nil
+				},
+				UpsertFunc: func(id int32, productRequest *dto.CreateProductRequest) (*domain.Product, bool, error) {
+					upserted = true
+					return &domain.Product{ID: id, Name: productRequest.Name, Price: productRequest.Price}, test.existing == nil, nil
+				},
+				UpdateIfUnmodifiedFunc: func(id int32, updatedAt time.Time, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+					updated = true
+					if !updatedAt.Equal(current.UpdatedAt) {
+						t.Errorf("updatedAt = %v, want the version the etag matched, %v", updatedAt, current.UpdatedAt)
+					}
+					if test.modified {
+						return nil, domain.ErrPreconditionFailed
+					}
+					return &domain.Product{ID: id, Name: productRequest.Name, Price: productRequest.Price}, nil
+				},
+			}, productservice.Options{})
+
+			request := httptest.NewRequest("PUT", "/product/7", strings.NewReader(`{"name":"Desk lamp","price":12}`))
+			if test.ifMatch != "" {
+				request.Header.Set("If-Match", test.ifMatch)
+			}
+			response := harness.DoRequest(request)
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if upserted != test.wantUpserted {
+				t.Errorf("upserted = %v, want %v", upserted, test.wantUpserted)
+			}
+			if updated != test.wantUpdated {
+				t.Errorf("conditionally updated = %v, want %v", updated, test.wantUpdated)
+			}
+			if location := response.Header().Get("Location"); (location == "/product/7") != (test.wantStatus == 201) {
+				t.Errorf("Location = %q", location)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package respond
+
+import (
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// Error writes status with the JSON error envelope.
+func Error(response http.ResponseWriter, request *http.Request, status int, code string, message string) {
+	JSON(response, request, status, dto.ErrorResponse{Code: code, Message: message})
+}
@@ -0,0 +1,30 @@
+// Package respond writes HTTP responses the same way for every service.
+package respond
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// JSON encodes value into a buffer before writing anything, so an encoding
+// failure still turns into a 500 with the error envelope instead of a
+// truncated body.
+func JSON(response http.ResponseWriter, request *http.Request, status int, value any) {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(value); err != nil {
+		logging.FromContext(request.Context()).Error("Unable to encode response", "error", err)
+		body.Reset()
+		json.NewEncoder(body).Encode(dto.ErrorResponse{Code: dto.ErrorCodeInternal, Message: "unable to encode response"})
+		status = 500
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(status)
+	if _, err := response.Write(body.Bytes()); err != nil {
+		logging.FromContext(request.Context()).Warn("Unable to write response", "error", err)
+	}
+}
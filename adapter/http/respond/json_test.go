@@ -0,0 +1,35 @@
+package respond
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		name            string
+		value           any
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{name: "encoded", value: map[string]int{"count": 2}, wantStatus: 202, wantContentType: "application/json", wantBody: "{\"count\":2}\n"},
+		{name: "unencodable", value: map[string]any{"callback": func() {}}, wantStatus: 500, wantContentType: "application/json", wantBody: "{\"code\":\"INTERNAL\",\"message\":\"unable to encode response\"}\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := httptest.NewRecorder()
+			JSON(response, httptest.NewRequest("GET", "/", nil), 202, test.value)
+
+			if response.Code != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.Code, test.wantStatus)
+			}
+			if contentType := response.Header().Get("Content-Type"); contentType != test.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", contentType, test.wantContentType)
+			}
+			if body := response.Body.String(); body != test.wantBody {
+				t.Errorf("body = %q, want %q", body, test.wantBody)
+			}
+		})
+	}
+}
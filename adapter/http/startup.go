@@ -0,0 +1,60 @@
+package main
+
+import (
+	"log/slog"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/features"
+	"github.com/spf13/viper"
+)
+
+// logStartupSummary logs the resolved configuration. Database credentials and
+// the admin token are never included.
+func logStartupSummary() {
+	databases := []any{}
+	for name, databaseURL := range configuredDatabases() {
+		host, database := databaseTarget(databaseURL)
+		databases = append(databases, slog.Group(name, "host", host, "database", database))
+	}
+
+	enabled := []string{}
+	for name := range viper.GetStringMap("features") {
+		if features.Enabled(name) {
+			enabled = append(enabled, name)
+		}
+	}
+	sort.Strings(enabled)
+
+	slog.Info("Starting",
+		"environment", viper.GetString("environment"),
+		"port", viper.GetString("server.port"),
+		slog.Group("databases", databases...),
+		"features", enabled,
+		"logLevel", viper.GetString("log.level"),
+		"logFormat", viper.GetString("log.format"),
+		"adminTokenSet", viper.GetString("admin.token") != "",
+	)
+}
+
+func configuredDatabases() map[string]string {
+	databases := map[string]string{}
+	for name := range viper.GetStringMap("databases") {
+		databases[name] = viper.GetString("databases." + name + ".url")
+	}
+	if _, ok := databases["primary"]; !ok {
+		databases["primary"] = viper.GetString("database.url")
+	}
+	return databases
+}
+
+// databaseTarget extracts host and database name from a database url as
+// stored in config, which omits the scheme prefix.
+func databaseTarget(databaseURL string) (string, string) {
+	parsed, err := url.Parse("postgres" + databaseURL)
+	if err != nil {
+		return "invalid", ""
+	}
+	return parsed.Host, strings.TrimPrefix(parsed.Path, "/")
+}
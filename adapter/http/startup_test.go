@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestLogStartupSummaryRedactsSecrets(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("server.port", "8080")
+	viper.Set("database.url", "://app:primary-secret@db.internal:5432/products")
+	viper.Set("databases.replica.url", "://reader:replica-secret@replica.internal:5432/products")
+	viper.Set("admin.token", "admin-secret")
+	viper.Set("features.facets", true)
+
+	var output bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&output, nil)))
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	logStartupSummary()
+
+	logged := output.String()
+	for _, secret := range []string{"primary-secret", "replica-secret", "admin-secret", "app:", "reader:"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("summary contains %q: %s", secret, logged)
+		}
+	}
+	for _, expected := range []string{"port=8080", "databases.primary.host=db.internal:5432", "databases.replica.database=products", "features=[facets]", "adminTokenSet=true"} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("summary missing %q: %s", expected, logged)
+		}
+	}
+}
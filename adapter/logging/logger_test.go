@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNewHonorsLevel(t *testing.T) {
+	tests := []struct {
+		level     string
+		wantDebug bool
+		wantInfo  bool
+		wantWarn  bool
+	}{
+		{level: "debug", wantDebug: true, wantInfo: true, wantWarn: true},
+		{level: "", wantInfo: true, wantWarn: true},
+		{level: "info", wantInfo: true, wantWarn: true},
+		{level: "WARN", wantWarn: true},
+		{level: "error"},
+	}
+	for _, test := range tests {
+		t.Run("level "+test.level, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			logger, err := New(test.level, "text", output)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			logger.Debug("Running query", "sql", "SELECT 1")
+			logger.Info("Request handled")
+			logger.Warn("Pool nearly exhausted")
+
+			for message, want := range map[string]bool{
+				"Running query":         test.wantDebug,
+				"Request handled":       test.wantInfo,
+				"Pool nearly exhausted": test.wantWarn,
+			} {
+				if got := strings.Contains(output.String(), message); got != want {
+					t.Errorf("logged %q = %v, want %v", message, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewFormat(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger, err := New("info", "json", output)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	logger.Info("Request handled", "status", 200)
+
+	record := map[string]any{}
+	if err := json.Unmarshal(output.Bytes(), &record); err != nil {
+		t.Fatalf("json output %q: %v", output.String(), err)
+	}
+	if record["msg"] != "Request handled" || record["status"] != float64(200) {
+		t.Errorf("record = %v", record)
+	}
+
+	if _, err := New("info", "xml", output); err == nil {
+		t.Error("New() with an unknown format error = nil")
+	}
+	if _, err := New("verbose", "text", output); err == nil {
+		t.Error("New() with an unknown level error = nil")
+	}
+}
@@ -0,0 +1,15 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var httpErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "http_errors_total",
+	Help: "Count of error responses by route and domain error class.",
+}, []string{"route", "class"})
+
+func RecordError(route string, class string) {
+	httpErrors.WithLabelValues(route, class).Inc()
+}
@@ -0,0 +1,15 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var dataAnomalies = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "data_anomalies",
+	Help: "Rows flagged by the last run of each data integrity check.",
+}, []string{"check"})
+
+func RecordIntegrityCheck(check string, count int64) {
+	dataAnomalies.WithLabelValues(check).Set(float64(count))
+}
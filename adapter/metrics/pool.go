@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	poolAcquiredConns = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_pool_acquired_conns",
+		Help: "Number of connections currently acquired from the pool.",
+	}, []string{"database"})
+	poolIdleConns = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_pool_idle_conns",
+		Help: "Number of idle connections in the pool.",
+	}, []string{"database"})
+	poolTotalConns = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_pool_total_conns",
+		Help: "Total number of connections in the pool.",
+	}, []string{"database"})
+	poolMaxConns = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_pool_max_conns",
+		Help: "Maximum size of the pool.",
+	}, []string{"database"})
+	poolAcquireCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_pool_acquire_count",
+		Help: "Cumulative count of successful acquires from the pool.",
+	}, []string{"database"})
+	poolAcquireDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_pool_acquire_duration_seconds",
+		Help: "Total time spent acquiring connections from the pool.",
+	}, []string{"database"})
+	poolEmptyAcquireCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_pool_empty_acquire_count",
+		Help: "Cumulative count of acquires that waited for a connection.",
+	}, []string{"database"})
+)
+
+func RecordPoolStats(database string, stats postgres.PoolStats) {
+	poolAcquiredConns.WithLabelValues(database).Set(float64(stats.AcquiredConns))
+	poolIdleConns.WithLabelValues(database).Set(float64(stats.IdleConns))
+	poolTotalConns.WithLabelValues(database).Set(float64(stats.TotalConns))
+	poolMaxConns.WithLabelValues(database).Set(float64(stats.MaxConns))
+	poolAcquireCount.WithLabelValues(database).Set(float64(stats.AcquireCount))
+	poolAcquireDuration.WithLabelValues(database).Set(stats.AcquireDuration.Seconds())
+	poolEmptyAcquireCount.WithLabelValues(database).Set(float64(stats.EmptyAcquireCount))
+}
+
+// UpdatePoolMetrics records poolStats, keyed by database name, every
+// interval until ctx is done.
+func UpdatePoolMetrics(ctx context.Context, poolStats func() map[string]postgres.PoolStats, interval time.Duration) {
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for database, stats := range poolStats() {
+			RecordPoolStats(database, stats)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
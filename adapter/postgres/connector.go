@@ -2,8 +2,8 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"os"
 
 	"github.com/jackc/pgconn"
@@ -34,22 +34,85 @@ type PoolInterface interface {
 	BeginTxFunc(ctx context.Context, txOptions pgx.TxOptions, f func(pgx.Tx) error) error
 }
 
+// DatabaseURL returns the url of the named database, falling back to
+// "database.url" for the primary database, as NewRegistry does.
+func DatabaseURL(name string) string {
+	if name == "" {
+		name = PrimaryDatabase
+	}
+	if url := viper.GetString("databases." + name + ".url"); url != "" || name != PrimaryDatabase {
+		return url
+	}
+	return viper.GetString("database.url")
+}
+
 func GetConnection(ctx context.Context) *pgxpool.Pool {
-	databaseURL := viper.GetString("database.url")
-	conn, err := pgxpool.Connect(ctx, "postgres"+databaseURL)
+	return Connect(ctx, viper.GetString("database.url"))
+}
+
+// Connect opens a pool for databaseURL.
+func Connect(ctx context.Context, databaseURL string) *pgxpool.Pool {
+	config, err := pgxpool.ParseConfig("postgres" + databaseURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to parse database url: %v\n", err)
+		return nil
+	}
+	if minConns := viper.GetInt32("db.minConns"); minConns > 0 {
+		config.MinConns = minConns
+	}
+
+	conn, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to connect to database: %v\n", err)
 	}
 	return conn
 }
 
-func RunMigrations() {
-	databaseURL := viper.GetString("database.url")
-	m, err := migrate.New("file://database/migrations", "pgx"+databaseURL)
+// migrationLockID is the advisory lock key that serializes migrations across
+// instances starting at the same time.
+const migrationLockID int64 = 4148771519
+
+// RunMigrations applies the migrations in migrationsDir, usually
+// MigrationsDir, to create table and the companion tables, functions and
+// triggers it needs in the database at databaseURL, creating the table's
+// schema first when it is qualified with one. It holds a session advisory
+// lock while migrating, so concurrent instances wait for the first one and
+// then find nothing left to apply.
+func RunMigrations(ctx context.Context, databaseURL string, migrationsDir string, table pgx.Identifier) error {
+	lockConn, err := pgx.Connect(ctx, "postgres"+databaseURL)
 	if err != nil {
-		log.Println(err)
+		return err
 	}
-	if err := m.Up(); err != nil {
-		log.Println(err)
+	defer lockConn.Close(context.Background())
+
+	if _, err := lockConn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return err
+	}
+	defer lockConn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+
+	if len(table) > 1 {
+		if _, err := lockConn.Exec(ctx, "CREATE SCHEMA IF NOT EXISTS "+pgx.Identifier{table[0]}.Sanitize()); err != nil {
+			return err
+		}
+	}
+	dir, err := renderMigrations(migrationsDir, table)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	migrationsURL, err := migrationsDatabaseURL(databaseURL, table)
+	if err != nil {
+		return err
+	}
+
+	m, err := migrate.New("file://"+dir, migrationsURL)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
 	}
+	return nil
 }
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+func TestRunMigrationsConcurrentlyIntegration(t *testing.T) {
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+	table := TableIdentifier(schema, DefaultTable)
+	migrationsDir := filepath.Join("..", "..", MigrationsDir)
+	t.Cleanup(func() {
+		conn, err := pgx.Connect(context.Background(), databaseURL)
+		if err != nil {
+			t.Errorf("dropping %s: %v", schema, err)
+			return
+		}
+		defer conn.Close(context.Background())
+		if _, err := conn.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+pgx.Identifier{schema}.Sanitize()+" CASCADE"); err != nil {
+			t.Errorf("dropping %s: %v", schema, err)
+		}
+	})
+
+	const runners = 2
+	errs := make([]error, runners)
+	var wg sync.WaitGroup
+	for i := 0; i < runners; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = RunMigrations(ctx, strings.TrimPrefix(databaseURL, "postgres"), migrationsDir, table)
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("runner %d: RunMigrations() error = %v", i, err)
+		}
+	}
+
+	conn, err := pgx.Connect(ctx, databaseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close(ctx)
+
+	ups, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var version int
+	var dirty bool
+	if err := conn.QueryRow(ctx, "SELECT version, dirty FROM "+CompanionTable(table, "schema_migrations").Sanitize()).Scan(&version, &dirty); err != nil {
+		t.Fatal(err)
+	}
+	if version != len(ups) || dirty {
+		t.Errorf("migrated to version %d (dirty %v), want %d", version, dirty, len(ups))
+	}
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type IntegrityCheck struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// CheckIntegrity counts rows that the schema allows but the application
+// never means to produce. It only reads; fixing anomalies is left to an
+// operator. Schedules due before overdueBefore count as missed by the price
+// scheduler.
+func CheckIntegrity(ctx context.Context, db PoolInterface, table pgx.Identifier, overdueBefore time.Time) ([]IntegrityCheck, error) {
+	name := table.Sanitize()
+	queries := []struct {
+		name  string
+		query string
+		args  []interface{}
+	}{
+		{
+			name:  "non_positive_price",
+			query: "SELECT COUNT(*) FROM " + name + " WHERE price <= 0",
+		},
+		{
+			name: "price_history_mismatch",
+			query: `SELECT COUNT(*) FROM ` + name + ` AS product
+				LEFT JOIN LATERAL (
+					SELECT price FROM ` + CompanionTable(table, "price_history").Sanitize() + `
+					WHERE product_id = product.id
+					ORDER BY changed_at DESC, id DESC
+					LIMIT 1
+				) AS latest ON true
+				WHERE latest.price IS DISTINCT FROM product.price`,
+		},
+		{
+			name:  "overdue_price_schedule",
+			query: "SELECT COUNT(*) FROM " + CompanionTable(table, "price_schedules").Sanitize() + " WHERE effective_at < $1",
+			args:  []interface{}{overdueBefore},
+		},
+	}
+
+	checks := make([]IntegrityCheck, 0, len(queries))
+	for _, query := range queries {
+		check := IntegrityCheck{Name: query.name}
+		if err := db.QueryRow(ctx, query.query, query.args...).Scan(&check.Count); err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
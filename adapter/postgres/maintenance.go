@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type IndexSize struct {
+	Name  string `json:"name"`
+	Bytes int64  `json:"bytes"`
+}
+
+type TableStats struct {
+	Table           string      `json:"table"`
+	RowEstimate     int64       `json:"rowEstimate"`
+	LiveTuples      int64       `json:"liveTuples"`
+	DeadTuples      int64       `json:"deadTuples"`
+	TableBytes      int64       `json:"tableBytes"`
+	IndexesBytes    int64       `json:"indexesBytes"`
+	TotalBytes      int64       `json:"totalBytes"`
+	Indexes         []IndexSize `json:"indexes"`
+	LastAnalyze     *time.Time  `json:"lastAnalyze,omitempty"`
+	LastAutoanalyze *time.Time  `json:"lastAutoanalyze,omitempty"`
+}
+
+// Reindex rebuilds the indexes of table without blocking writes to it, then
+// analyzes it. A cancelled rebuild can leave invalid "_ccnew" indexes
+// behind, which the next reindex replaces.
+func Reindex(ctx context.Context, db PoolInterface, table pgx.Identifier) error {
+	if _, err := db.Exec(ctx, "REINDEX TABLE CONCURRENTLY "+table.Sanitize()); err != nil {
+		return err
+	}
+	return Analyze(ctx, db, table)
+}
+
+func Analyze(ctx context.Context, db PoolInterface, table pgx.Identifier) error {
+	_, err := db.Exec(ctx, "ANALYZE "+table.Sanitize())
+	return err
+}
+
+// GetTableStats reads size and tuple statistics for table. RowEstimate is the
+// planner estimate and is zero until the table has been analyzed.
+func GetTableStats(ctx context.Context, db PoolInterface, table pgx.Identifier) (*TableStats, error) {
+	name := table.Sanitize()
+	stats := TableStats{Table: name, Indexes: []IndexSize{}}
+	err := db.QueryRow(
+		ctx,
+		`SELECT GREATEST(c.reltuples, 0)::BIGINT,
+			COALESCE(s.n_live_tup, 0),
+			COALESCE(s.n_dead_tup, 0),
+			pg_relation_size(c.oid),
+			pg_indexes_size(c.oid),
+			pg_total_relation_size(c.oid),
+			s.last_analyze,
+			s.last_autoanalyze
+		FROM pg_class c
+		LEFT JOIN pg_stat_user_tables s ON s.relid = c.oid
+		WHERE c.oid = $1::regclass`,
+		name,
+	).Scan(
+		&stats.RowEstimate,
+		&stats.LiveTuples,
+		&stats.DeadTuples,
+		&stats.TableBytes,
+		&stats.IndexesBytes,
+		&stats.TotalBytes,
+		&stats.LastAnalyze,
+		&stats.LastAutoanalyze,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(
+		ctx,
+		"SELECT indexrelid::regclass::TEXT, pg_relation_size(indexrelid) FROM pg_index WHERE indrelid = $1::regclass ORDER BY 1",
+		name,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		index := IndexSize{}
+		if err := rows.Scan(&index.Name, &index.Bytes); err != nil {
+			return nil, err
+		}
+		stats.Indexes = append(stats.Indexes, index)
+	}
+
+	return &stats, rows.Err()
+}
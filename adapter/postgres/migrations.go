@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// MigrationsDir holds the migration templates, relative to the working
+// directory the service starts in.
+const MigrationsDir = "database/migrations"
+
+// renderMigrations renders every migration in dir for table into a new
+// temporary directory and returns its path. The migrations name the product
+// table {{table}}, the functions and companion tables it owns in its schema
+// {{qualified "record_%s_tombstone"}}, and its indexes and triggers, which
+// Postgres names without a schema, {{name "%s_updated_at_idx"}}; each %s is
+// replaced by the product table name. The caller removes the directory.
+func renderMigrations(dir string, table pgx.Identifier) (string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return "", err
+	}
+	rendered, err := os.MkdirTemp("", "migrations")
+	if err != nil {
+		return "", err
+	}
+	name := table[len(table)-1]
+	funcs := template.FuncMap{
+		"table": table.Sanitize,
+		"qualified": func(format string) string {
+			return inSchema(table, fmt.Sprintf(format, name)).Sanitize()
+		},
+		"name": func(format string) string {
+			return pgx.Identifier{fmt.Sprintf(format, name)}.Sanitize()
+		},
+	}
+	for _, file := range files {
+		if err := renderMigration(file, filepath.Join(rendered, filepath.Base(file)), funcs); err != nil {
+			os.RemoveAll(rendered)
+			return "", err
+		}
+	}
+	return rendered, nil
+}
+
+func renderMigration(source string, destination string, funcs template.FuncMap) error {
+	text, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+	migration, err := template.New(filepath.Base(source)).Funcs(funcs).Parse(string(text))
+	if err != nil {
+		return err
+	}
+	var output strings.Builder
+	if err := migration.Execute(&output, nil); err != nil {
+		return err
+	}
+	return os.WriteFile(destination, []byte(output.String()), 0o600)
+}
+
+// migrationsDatabaseURL points golang-migrate at databaseURL. The default
+// product table keeps the default schema_migrations table; any other one
+// records its version in its own <table>_schema_migrations next to it, so
+// two product tables in one database migrate independently.
+func migrationsDatabaseURL(databaseURL string, table pgx.Identifier) (string, error) {
+	name := table[len(table)-1]
+	if name == DefaultTable && (len(table) == 1 || table[0] == DefaultSchema) {
+		return "pgx" + databaseURL, nil
+	}
+	parsed, err := url.Parse("pgx" + databaseURL)
+	if err != nil {
+		return "", err
+	}
+	query := parsed.Query()
+	query.Set("x-migrations-table", CompanionTable(table, "schema_migrations").Sanitize())
+	query.Set("x-migrations-table-quoted", "1")
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+)
+
+func TestRenderMigrations(t *testing.T) {
+	templates, err := filepath.Glob(filepath.Join("..", "..", MigrationsDir, "*.sql"))
+	if err != nil || len(templates) == 0 {
+		t.Fatalf("no migrations found: %v", err)
+	}
+
+	dir, err := renderMigrations(filepath.Join("..", "..", MigrationsDir), pgx.Identifier{"catalog", "items"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Every object the migrations create is named after the configured
+	// table; only the product_id columns and comments keep the default name.
+	product := regexp.MustCompile(`\bproduct\b|product_[a-z]`)
+	comment := regexp.MustCompile(`(?m)--.*$`)
+	for _, template := range templates {
+		rendered, err := os.ReadFile(filepath.Join(dir, filepath.Base(template)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sql := strings.ReplaceAll(comment.ReplaceAllString(string(rendered), ""), "product_id", "")
+		if match := product.FindString(sql); match != "" {
+			t.Errorf("%s still names %q:\n%s", filepath.Base(template), match, rendered)
+		}
+		if strings.Contains(sql, "{{") {
+			t.Errorf("%s was not rendered:\n%s", filepath.Base(template), rendered)
+		}
+	}
+
+	tombstones, err := os.ReadFile(filepath.Join(dir, "000006_create_product_tombstones_table.up.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`CREATE TABLE "catalog"."items_tombstones"`,
+		`CREATE INDEX "items_tombstones_deleted_at_idx" ON "catalog"."items_tombstones"`,
+		`CREATE OR REPLACE FUNCTION "catalog"."record_items_tombstone"()`,
+		`AFTER DELETE ON "catalog"."items"`,
+	} {
+		if !strings.Contains(string(tombstones), want) {
+			t.Errorf("tombstones migration does not contain %s:\n%s", want, tombstones)
+		}
+	}
+}
+
+func TestMigrationsDatabaseURL(t *testing.T) {
+	tests := []struct {
+		table pgx.Identifier
+		want  string
+	}{
+		{table: pgx.Identifier{"product"}, want: "pgx://localhost/db?sslmode=disable"},
+		{table: pgx.Identifier{"public", "product"}, want: "pgx://localhost/db?sslmode=disable"},
+		{table: pgx.Identifier{"catalog", "items"}, want: "pgx://localhost/db?sslmode=disable&x-migrations-table=%22catalog%22.%22items_schema_migrations%22&x-migrations-table-quoted=1"},
+	}
+	for _, test := range tests {
+		t.Run(test.table.Sanitize(), func(t *testing.T) {
+			got, err := migrationsDatabaseURL("://localhost/db?sslmode=disable", test.table)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("url = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
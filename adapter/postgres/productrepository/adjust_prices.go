@@ -0,0 +1,46 @@
+package productrepository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/jackc/pgx/v4"
+)
+
+func (repository repository) AdjustPrices(ctx context.Context, adjustPriceRequest *dto.AdjustPriceRequest) (int64, error) {
+	builder := &queryBuilder{}
+	factor := builder.arg(adjustPriceRequest.Factor())
+	if adjustPriceRequest.NameContains != "" {
+		builder.where(fmt.Sprintf("STRPOS(LOWER(name), LOWER(%s)) > 0", builder.arg(adjustPriceRequest.NameContains)))
+	}
+	if adjustPriceRequest.Filter != nil {
+		builder.where(builder.compileFilter(adjustPriceRequest.Filter))
+	}
+	query := "WITH updated AS (UPDATE " + repository.tableName + " SET price = ROUND((price * " + factor + ")::numeric, 2)" + builder.whereClause() + " RETURNING price)" +
+		" SELECT COUNT(*), COUNT(*) FILTER (WHERE price <= 0) FROM updated"
+	logging.FromContext(ctx).Debug("Adjusting product prices", "sql", query)
+
+	var affected, nonPositive int64
+	err := repository.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, query, builder.args...).Scan(&affected, &nonPositive); err != nil {
+			return err
+		}
+		// Rounding can still take a cheap product to zero; roll the whole
+		// adjustment back rather than leave it half applied.
+		if nonPositive > 0 {
+			return fmt.Errorf("%w: adjustment would leave %d products with a price of zero or less", domain.ErrValidation, nonPositive)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if affected > 0 {
+		repository.invalidateTotals()
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,45 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestAdjustPrices(t *testing.T) {
+	tests := []struct {
+		name         string
+		nonPositive  int64
+		wantErr      error
+		wantAffected int64
+	}{
+		{name: "applied", wantAffected: 3},
+		{name: "rounded to zero", nonPositive: 1, wantErr: domain.ErrValidation},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+				return [][]interface{}{{int64(3), test.nonPositive}}, nil
+			}}
+
+			affected, err := newTestRepository(pool, Options{}).AdjustPrices(context.Background(), &dto.AdjustPriceRequest{Percentage: 10, NameContains: "chair"})
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("AdjustPrices() error = %v, want %v", err, test.wantErr)
+			}
+			if affected != test.wantAffected {
+				t.Errorf("AdjustPrices() = %d, want %d", affected, test.wantAffected)
+			}
+			if len(pool.queries) != 1 {
+				t.Fatalf("ran %d queries, want 1", len(pool.queries))
+			}
+			args := pool.queries[0].args
+			if len(args) != 2 || math.Abs(args[0].(float64)-1.1) > 1e-6 || args[1] != "chair" {
+				t.Errorf("args = %v, want [1.1 chair]", args)
+			}
+		})
+	}
+}
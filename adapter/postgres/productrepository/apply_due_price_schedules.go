@@ -0,0 +1,32 @@
+package productrepository
+
+import (
+	"context"
+	"time"
+)
+
+func (repository repository) ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int64, error) {
+	commandTag, err := repository.db.Exec(
+		ctx,
+		`WITH due AS (
+			DELETE FROM `+repository.companionTable("price_schedules")+` WHERE effective_at <= $1
+			RETURNING product_id, new_price, effective_at
+		), latest AS (
+			SELECT DISTINCT ON (product_id) product_id, new_price
+			FROM due
+			ORDER BY product_id, effective_at DESC
+		)
+		UPDATE `+repository.tableName+` AS product SET price = latest.new_price
+		FROM latest
+		WHERE product.id = latest.product_id`,
+		now,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if commandTag.RowsAffected() > 0 {
+		repository.invalidateTotals()
+	}
+
+	return commandTag.RowsAffected(), nil
+}
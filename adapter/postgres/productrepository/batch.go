@@ -0,0 +1,138 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/jackc/pgx/v4"
+)
+
+var errBatchRolledBack = errors.New("batch rolled back")
+
+// Batch runs the operations in order in one transaction. Operation failures
+// are reported in the results; the returned error is reserved for failures
+// of the whole call. With AllowPartial each operation runs in its own
+// savepoint so a failure only undoes that operation.
+func (repository repository) Batch(ctx context.Context, batchRequest *dto.BatchRequest) ([]domain.BatchResult, error) {
+	operations := batchRequest.Operations
+	results := make([]domain.BatchResult, len(operations))
+	for i, operation := range operations {
+		results[i] = domain.BatchResult{Index: i, Op: operation.Op, ID: operation.ID, Status: domain.BatchStatusSkipped}
+	}
+
+	err := repository.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for i := range operations {
+			var product *domain.Product
+			var err error
+			if batchRequest.AllowPartial {
+				err = tx.BeginFunc(ctx, func(savepoint pgx.Tx) error {
+					product, err = repository.runBatchOperation(ctx, savepoint, &operations[i])
+					return err
+				})
+			} else {
+				product, err = repository.runBatchOperation(ctx, tx, &operations[i])
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			if err != nil {
+				results[i].Status = domain.BatchStatusFailed
+				results[i].Error = err.Error()
+				if !batchRequest.AllowPartial {
+					return errBatchRolledBack
+				}
+				continue
+			}
+			results[i].Status = domain.BatchStatusOK
+			results[i].Product = product
+			if product != nil {
+				results[i].ID = product.ID
+			}
+		}
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, errBatchRolledBack):
+		for i := range results {
+			if results[i].Status == domain.BatchStatusOK {
+				results[i].Status = domain.BatchStatusRolledBack
+				results[i].Product = nil
+				if results[i].Op == dto.BatchOpCreate {
+					results[i].ID = 0
+				}
+			}
+		}
+		return results, nil
+	case err != nil:
+		return nil, err
+	}
+	repository.invalidateTotals()
+
+	return results, nil
+}
+
+func (repository repository) runBatchOperation(ctx context.Context, tx pgx.Tx, operation *dto.BatchOperation) (*domain.Product, error) {
+	switch operation.Op {
+	case dto.BatchOpDelete:
+		commandTag, err := tx.Exec(ctx, "DELETE FROM "+repository.tableName+" WHERE id = $1", operation.ID)
+		if err != nil {
+			return nil, referencedError(err)
+		}
+		if commandTag.RowsAffected() == 0 {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, nil
+	case dto.BatchOpUpdate:
+		product, err := scanProduct(tx.QueryRow(
+			ctx,
+			`UPDATE `+repository.tableName+` SET
+				name = $2,
+				price = $3,
+				description = $4,
+				discount_type = $5,
+				discount_value = $6,
+				discount_starts_at = $7,
+				discount_ends_at = $8
+			WHERE id = $1
+			RETURNING `+productColumns,
+			append([]interface{}{operation.ID}, productArgs(operation.Product)...)...,
+		))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrProductNotFound
+		}
+		return product, err
+	default:
+		return scanProduct(tx.QueryRow(
+			ctx,
+			"INSERT INTO "+repository.tableName+" (name, price, description, discount_type, discount_value, discount_starts_at, discount_ends_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING "+productColumns,
+			productArgs(operation.Product)...,
+		))
+	}
+}
+
+// productArgs lists the writable columns of productRequest in insert order.
+func productArgs(productRequest *dto.CreateProductRequest) []interface{} {
+	var discountType *string
+	var discountValue *float32
+	var discountStartsAt, discountEndsAt *time.Time
+	if discount := productRequest.Discount; discount != nil {
+		discountType = &discount.Type
+		discountValue = &discount.Value
+		discountStartsAt = discount.StartsAt
+		discountEndsAt = discount.EndsAt
+	}
+	return []interface{}{
+		productRequest.Name,
+		productRequest.Price,
+		productRequest.Description,
+		discountType,
+		discountValue,
+		discountStartsAt,
+		discountEndsAt,
+	}
+}
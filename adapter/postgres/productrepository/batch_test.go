@@ -0,0 +1,114 @@
+package productrepository
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// batchPool answers inserts with product 7, updates of product 1 with
+// product 1, and deletes of any id as one deleted row. Updates of any other id
+// find no row.
+func batchPool(updatedAt time.Time) *fakePool {
+	return &fakePool{
+		commandTag: pgconn.CommandTag("DELETE 1"),
+		respond: func(sql string, args []interface{}) ([][]interface{}, error) {
+			switch {
+			case strings.HasPrefix(sql, "INSERT"):
+				return [][]interface{}{{int32(7), "Lamp", float32(12), "Desk lamp", nil, nil, nil, nil, updatedAt}}, nil
+			case strings.HasPrefix(sql, "UPDATE") && args[0] == int32(1):
+				return [][]interface{}{{int32(1), "Chair", float32(30), "Oak chair", nil, nil, nil, nil, updatedAt}}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func batchOperations(updateID int32) []dto.BatchOperation {
+	return []dto.BatchOperation{
+		{Op: dto.BatchOpCreate, Product: &dto.CreateProductRequest{Name: "Lamp", Price: 12, Description: "Desk lamp"}},
+		{Op: dto.BatchOpUpdate, ID: updateID, Product: &dto.CreateProductRequest{Name: "Chair", Price: 30, Description: "Oak chair"}},
+		{Op: dto.BatchOpDelete, ID: 2},
+	}
+}
+
+func TestBatch(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("all succeed", func(t *testing.T) {
+		pool := batchPool(updatedAt)
+		results, err := newTestRepository(pool, Options{}).Batch(context.Background(), &dto.BatchRequest{Operations: batchOperations(1)})
+		if err != nil {
+			t.Fatalf("Batch() error = %v", err)
+		}
+		want := []domain.BatchResult{
+			{Index: 0, Op: dto.BatchOpCreate, ID: 7, Status: domain.BatchStatusOK, Product: &domain.Product{ID: 7, Name: "Lamp", Price: 12, Description: "Desk lamp", UpdatedAt: updatedAt}},
+			{Index: 1, Op: dto.BatchOpUpdate, ID: 1, Status: domain.BatchStatusOK, Product: &domain.Product{ID: 1, Name: "Chair", Price: 30, Description: "Oak chair", UpdatedAt: updatedAt}},
+			{Index: 2, Op: dto.BatchOpDelete, ID: 2, Status: domain.BatchStatusOK},
+		}
+		if !reflect.DeepEqual(results, want) {
+			t.Errorf("Batch() = %+v, want %+v", results, want)
+		}
+		if len(pool.txOptions) != 1 {
+			t.Errorf("transactions = %d, want 1", len(pool.txOptions))
+		}
+	})
+
+	t.Run("failure rolls back", func(t *testing.T) {
+		pool := batchPool(updatedAt)
+		results, err := newTestRepository(pool, Options{}).Batch(context.Background(), &dto.BatchRequest{Operations: batchOperations(9)})
+		if err != nil {
+			t.Fatalf("Batch() error = %v", err)
+		}
+		want := []domain.BatchResult{
+			{Index: 0, Op: dto.BatchOpCreate, Status: domain.BatchStatusRolledBack},
+			{Index: 1, Op: dto.BatchOpUpdate, ID: 9, Status: domain.BatchStatusFailed, Error: domain.ErrProductNotFound.Error()},
+			{Index: 2, Op: dto.BatchOpDelete, ID: 2, Status: domain.BatchStatusSkipped},
+		}
+		if !reflect.DeepEqual(results, want) {
+			t.Errorf("Batch() = %+v, want %+v", results, want)
+		}
+		if len(pool.queries) != 2 {
+			t.Errorf("statements run = %d, want 2: the operations after the failure are skipped", len(pool.queries))
+		}
+	})
+}
+
+func TestBatchIntegration(t *testing.T) {
+	ctx := context.Background()
+	for _, allowPartial := range []bool{false, true} {
+		repository := integrationRepository(t, Options{})
+		for _, name := range []string{"Chair", "Table"} {
+			if _, err := repository.Create(ctx, &dto.CreateProductRequest{Name: name, Price: 10, Description: name}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		results, err := repository.Batch(ctx, &dto.BatchRequest{Operations: batchOperations(9), AllowPartial: allowPartial})
+		if err != nil {
+			t.Fatalf("Batch(allowPartial=%v) error = %v", allowPartial, err)
+		}
+		if results[1].Status != domain.BatchStatusFailed {
+			t.Errorf("Batch(allowPartial=%v) update status = %s, want %s", allowPartial, results[1].Status, domain.BatchStatusFailed)
+		}
+
+		var names []string
+		for _, product := range allProducts(t, repository) {
+			names = append(names, product.Name)
+		}
+		want := []string{"Chair", "Table"}
+		if allowPartial {
+			want = []string{"Chair", "Lamp"}
+		}
+		if !reflect.DeepEqual(names, want) {
+			t.Errorf("Batch(allowPartial=%v) left %v, want %v", allowPartial, names, want)
+		}
+	}
+}
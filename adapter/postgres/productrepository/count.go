@@ -0,0 +1,136 @@
+package productrepository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+type explainPlan struct {
+	Plan struct {
+		PlanRows float64 `json:"Plan Rows"`
+		Plans    []struct {
+			PlanRows float64 `json:"Plan Rows"`
+		} `json:"Plans"`
+	} `json:"Plan"`
+}
+
+const totalKeyPrefix = "product:total:"
+
+type countResult struct {
+	total    int32
+	strategy string
+	capped   bool
+}
+
+// count serves totals from Options.TotalCache when one is configured. Writes
+// call invalidateTotals, so a cached total is never older than the last write
+// through this process.
+func (repository repository) count(ctx context.Context, queryCount string, args []interface{}, filtered bool) (*countResult, error) {
+	if repository.options.TotalCache == nil {
+		return repository.countUncached(ctx, queryCount, args, filtered)
+	}
+
+	key, err := json.Marshal(append([]interface{}{queryCount}, args...))
+	if err != nil {
+		return repository.countUncached(ctx, queryCount, args, filtered)
+	}
+	if cached, ok := repository.options.TotalCache.Get(totalKeyPrefix + string(key)); ok {
+		result := cached.(countResult)
+		return &result, nil
+	}
+
+	result, err := repository.countUncached(ctx, queryCount, args, filtered)
+	if err != nil {
+		return nil, err
+	}
+	repository.options.TotalCache.Set(totalKeyPrefix+string(key), *result, repository.options.TotalCacheTTL)
+	return result, nil
+}
+
+func (repository repository) invalidateTotals() {
+	if repository.options.TotalCache != nil {
+		repository.options.TotalCache.DeletePrefix(totalKeyPrefix)
+	}
+}
+
+func (repository repository) countUncached(ctx context.Context, queryCount string, args []interface{}, filtered bool) (*countResult, error) {
+	switch repository.options.CountStrategy {
+	case domain.CountStrategyEstimate:
+		total, ok, err := repository.estimateCount(ctx, queryCount, args, filtered)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &countResult{total: total, strategy: domain.CountStrategyEstimate}, nil
+		}
+	case domain.CountStrategyCap:
+		total, capped, err := repository.cappedCount(ctx, queryCount, args)
+		if err != nil {
+			return nil, err
+		}
+		return &countResult{total: total, strategy: domain.CountStrategyCap, capped: capped}, nil
+	}
+
+	total := int32(0)
+	if err := repository.db.QueryRow(ctx, queryCount, args...).Scan(&total); err != nil {
+		return nil, err
+	}
+	return &countResult{total: total, strategy: domain.CountStrategyExact}, nil
+}
+
+func (repository repository) estimateCount(ctx context.Context, queryCount string, args []interface{}, filtered bool) (int32, bool, error) {
+	if !filtered {
+		estimate := float64(0)
+		err := repository.db.QueryRow(
+			ctx,
+			"SELECT reltuples FROM pg_class WHERE oid = $1::regclass",
+			repository.tableName,
+		).Scan(&estimate)
+		if err != nil {
+			return 0, false, err
+		}
+		if estimate < 0 {
+			return 0, false, nil
+		}
+		return int32(estimate), true, nil
+	}
+
+	planJSON := []byte{}
+	err := repository.db.QueryRow(ctx, "EXPLAIN (FORMAT JSON) "+queryCount, args...).Scan(&planJSON)
+	if err != nil {
+		return 0, false, err
+	}
+
+	plans := []explainPlan{}
+	if err := json.Unmarshal(planJSON, &plans); err != nil {
+		return 0, false, err
+	}
+	if len(plans) == 0 || len(plans[0].Plan.Plans) == 0 {
+		return 0, false, nil
+	}
+	return int32(plans[0].Plan.Plans[0].PlanRows), true, nil
+}
+
+func (repository repository) cappedCount(ctx context.Context, queryCount string, args []interface{}) (int32, bool, error) {
+	countCap := repository.options.CountCap
+	query := fmt.Sprintf(
+		"SELECT COUNT(*) FROM (%s LIMIT %d) capped",
+		strings.Replace(queryCount, "COUNT(id)", "1", 1),
+		countCap+1,
+	)
+
+	logging.FromContext(ctx).Debug("Counting products with cap", "sql", query)
+	total := int32(0)
+	if err := repository.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, false, err
+	}
+	if total > countCap {
+		return countCap, true, nil
+	}
+	return total, false, nil
+}
@@ -0,0 +1,27 @@
+package productrepository
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// Count runs only the count query of Fetch. The returned pagination carries
+// the total and how it was counted, without items.
+func (repository repository) Count(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	_, queryCount, args := repository.fetchQuery(pagination)
+	logging.FromContext(ctx).Debug("Counting products", "countSql", queryCount)
+
+	count, err := repository.count(ctx, queryCount, args, len(args) > 0)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Pagination[[]domain.Product]{
+		Items:         []domain.Product{},
+		Total:         count.total,
+		CountStrategy: count.strategy,
+		TotalCapped:   count.capped,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package productrepository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestCountRunsOnlyTheCountQuery(t *testing.T) {
+	pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+		return [][]interface{}{{int32(12)}}, nil
+	}}
+	pagination := &dto.PaginationRequestParams{Search: "%' --", Page: 1, ItemsPerPage: 10}
+
+	products, err := newTestRepository(pool, Options{}).Count(context.Background(), pagination)
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if products.Total != 12 || len(products.Items) != 0 || products.CountStrategy != domain.CountStrategyExact {
+		t.Errorf("Count() = %+v, want an exact total of 12 without items", products)
+	}
+	if len(pool.queries) != 1 {
+		t.Fatalf("ran %d queries, want 1", len(pool.queries))
+	}
+	want := recordedQuery{
+		sql:  `SELECT COUNT(id) FROM "product" WHERE (STRPOS(LOWER(name), LOWER($1)) > 0 OR STRPOS(LOWER(description), LOWER($1)) > 0)`,
+		args: []interface{}{"%' --"},
+	}
+	if !reflect.DeepEqual(pool.queries[0], want) {
+		t.Errorf("query = %+v, want %+v", pool.queries[0], want)
+	}
+}
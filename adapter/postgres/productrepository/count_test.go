@@ -0,0 +1,183 @@
+package productrepository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/cache"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestCountStrategies(t *testing.T) {
+	unfiltered := dto.DefaultPaginationRequestParams()
+	filtered := &dto.PaginationRequestParams{Name: "Chair", Page: 1, ItemsPerPage: 10}
+	tests := []struct {
+		name         string
+		options      Options
+		pagination   *dto.PaginationRequestParams
+		answers      map[string]interface{}
+		wantTotal    int32
+		wantCapped   bool
+		wantQueries  []string
+		wantStrategy string
+	}{
+		{
+			name:         "exact",
+			options:      Options{CountStrategy: domain.CountStrategyExact},
+			pagination:   unfiltered,
+			answers:      map[string]interface{}{"SELECT COUNT(id)": int32(12345)},
+			wantTotal:    12345,
+			wantQueries:  []string{"SELECT COUNT(id)"},
+			wantStrategy: domain.CountStrategyExact,
+		},
+		{
+			name:         "estimate from reltuples",
+			options:      Options{CountStrategy: domain.CountStrategyEstimate},
+			pagination:   unfiltered,
+			answers:      map[string]interface{}{"SELECT reltuples": float64(50000)},
+			wantTotal:    50000,
+			wantQueries:  []string{"SELECT reltuples"},
+			wantStrategy: domain.CountStrategyEstimate,
+		},
+		{
+			name:         "estimate on a never analyzed table",
+			options:      Options{CountStrategy: domain.CountStrategyEstimate},
+			pagination:   unfiltered,
+			answers:      map[string]interface{}{"SELECT reltuples": float64(-1), "SELECT COUNT(id)": int32(7)},
+			wantTotal:    7,
+			wantQueries:  []string{"SELECT reltuples", "SELECT COUNT(id)"},
+			wantStrategy: domain.CountStrategyExact,
+		},
+		{
+			name:         "estimate from the plan",
+			options:      Options{CountStrategy: domain.CountStrategyEstimate},
+			pagination:   filtered,
+			answers:      map[string]interface{}{"EXPLAIN": []byte(`[{"Plan":{"Plan Rows":1,"Plans":[{"Plan Rows":420}]}}]`)},
+			wantTotal:    420,
+			wantQueries:  []string{"EXPLAIN (FORMAT JSON) SELECT COUNT(id)"},
+			wantStrategy: domain.CountStrategyEstimate,
+		},
+		{
+			name:         "cap not reached",
+			options:      Options{CountStrategy: domain.CountStrategyCap, CountCap: 100},
+			pagination:   unfiltered,
+			answers:      map[string]interface{}{"SELECT COUNT(*) FROM (SELECT 1": int32(42)},
+			wantTotal:    42,
+			wantQueries:  []string{"SELECT COUNT(*) FROM (SELECT 1"},
+			wantStrategy: domain.CountStrategyCap,
+		},
+		{
+			name:         "cap reached",
+			options:      Options{CountStrategy: domain.CountStrategyCap, CountCap: 100},
+			pagination:   unfiltered,
+			answers:      map[string]interface{}{"SELECT COUNT(*) FROM (SELECT 1": int32(101)},
+			wantTotal:    100,
+			wantCapped:   true,
+			wantQueries:  []string{"SELECT COUNT(*) FROM (SELECT 1"},
+			wantStrategy: domain.CountStrategyCap,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := &fakePool{respond: func(sql string, args []interface{}) ([][]interface{}, error) {
+				for prefix, answer := range test.answers {
+					if strings.HasPrefix(sql, prefix) {
+						return [][]interface{}{{answer}}, nil
+					}
+				}
+				t.Fatalf("unexpected query %q", sql)
+				return nil, nil
+			}}
+
+			products, err := newTestRepository(pool, test.options).Count(context.Background(), test.pagination)
+			if err != nil {
+				t.Fatalf("Count() error = %v", err)
+			}
+			if products.Total != test.wantTotal || products.TotalCapped != test.wantCapped || products.CountStrategy != test.wantStrategy {
+				t.Errorf("Count() = total %d, capped %v, strategy %q, want %d, %v, %q",
+					products.Total, products.TotalCapped, products.CountStrategy, test.wantTotal, test.wantCapped, test.wantStrategy)
+			}
+			if len(pool.queries) != len(test.wantQueries) {
+				t.Fatalf("ran %d queries, want %d", len(pool.queries), len(test.wantQueries))
+			}
+			for i, prefix := range test.wantQueries {
+				if !strings.HasPrefix(pool.queries[i].sql, prefix) {
+					t.Errorf("query %d = %q, want it to start with %q", i, pool.queries[i].sql, prefix)
+				}
+			}
+		})
+	}
+}
+
+func TestCappedCountLimitsTheScan(t *testing.T) {
+	pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+		return [][]interface{}{{int32(3)}}, nil
+	}}
+
+	_, err := newTestRepository(pool, Options{CountStrategy: domain.CountStrategyCap, CountCap: 100}).Count(context.Background(), dto.DefaultPaginationRequestParams())
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if want := `SELECT COUNT(*) FROM (SELECT 1 FROM "product" LIMIT 101) capped`; pool.queries[0].sql != want {
+		t.Errorf("sql = %q, want %q", pool.queries[0].sql, want)
+	}
+}
+
+func TestTotalCache(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	total := int32(12)
+	pool := &fakePool{respond: func(sql string, args []interface{}) ([][]interface{}, error) {
+		switch {
+		case strings.HasPrefix(sql, "SELECT COUNT"):
+			return [][]interface{}{{total}}, nil
+		case strings.HasPrefix(sql, "INSERT"):
+			return [][]interface{}{{int32(13), "Chair", float32(10), "Oak chair", nil, nil, nil, nil, updatedAt}}, nil
+		}
+		return nil, nil
+	}}
+	repository := newTestRepository(pool, Options{TotalCache: cache.NewMemory(10), TotalCacheTTL: time.Minute})
+	ctx := context.Background()
+	fetch := func(search string) int32 {
+		t.Helper()
+		pagination := &dto.PaginationRequestParams{Search: search}
+		pagination.Normalize()
+		products, err := repository.Fetch(ctx, pagination)
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		return products.Total
+	}
+	counts := func() int {
+		n := 0
+		for _, query := range pool.queries {
+			if strings.HasPrefix(query.sql, "SELECT COUNT") {
+				n++
+			}
+		}
+		return n
+	}
+
+	fetch("oak")
+	fetch("oak")
+	if got := counts(); got != 1 {
+		t.Fatalf("count queries after two identical fetches = %d, want 1", got)
+	}
+	fetch("pine")
+	if got := counts(); got != 2 {
+		t.Fatalf("count queries after a different filter = %d, want 2", got)
+	}
+
+	if _, err := repository.Create(ctx, &dto.CreateProductRequest{Name: "Chair", Price: 10, Description: "Oak chair"}); err != nil {
+		t.Fatal(err)
+	}
+	total = 13
+	if got := fetch("oak"); got != 13 {
+		t.Errorf("total after Create = %d, want 13", got)
+	}
+	if got := counts(); got != 3 {
+		t.Errorf("count queries after Create = %d, want 3", got)
+	}
+}
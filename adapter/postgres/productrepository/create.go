@@ -2,31 +2,40 @@ package productrepository
 
 import (
 	"context"
+	"time"
 
 	"github.com/gabriwl165/clean-arch-go/core/domain"
 	"github.com/gabriwl165/clean-arch-go/core/dto"
 )
 
-func (repository repository) Create(productRequest *dto.CreateProductRequest) (*domain.Product, error) {
-	ctx := context.Background()
-	product := domain.Product{}
-	err := repository.db.QueryRow(
+func (repository repository) Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	var discountType *string
+	var discountValue *float32
+	var discountStartsAt, discountEndsAt *time.Time
+	if discount := productRequest.Discount; discount != nil {
+		discountType = &discount.Type
+		discountValue = &discount.Value
+		discountStartsAt = discount.StartsAt
+		discountEndsAt = discount.EndsAt
+	}
+
+	product, err := scanProduct(repository.db.QueryRow(
 		ctx,
-		"INSERT INTO product (name, price, description) VALUES ($1, $2, $3) returning *",
+		"INSERT INTO "+repository.tableName+" (name, price, description, discount_type, discount_value, discount_starts_at, discount_ends_at) VALUES ($1, $2, $3, $4, $5, $6, $7) returning "+productColumns,
 		productRequest.Name,
 		productRequest.Price,
 		productRequest.Description,
-	).Scan(
-		&product.ID,
-		&product.Name,
-		&product.Price,
-		&product.Description,
-	)
+		discountType,
+		discountValue,
+		discountStartsAt,
+		discountEndsAt,
+	))
 
 	if err != nil {
 		return nil, err
 	}
+	repository.invalidateTotals()
 
-	return &product, nil
+	return product, nil
 
 }
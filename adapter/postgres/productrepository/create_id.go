@@ -0,0 +1,22 @@
+package productrepository
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// CreateID is Create for clients that only need the new id, so it skips
+// reading the created row back.
+func (repository repository) CreateID(ctx context.Context, productRequest *dto.CreateProductRequest) (int32, error) {
+	var id int32
+	if err := repository.db.QueryRow(
+		ctx,
+		"INSERT INTO "+repository.tableName+" (name, price, description, discount_type, discount_value, discount_starts_at, discount_ends_at) VALUES ($1, $2, $3, $4, $5, $6, $7) returning id",
+		productArgs(productRequest)...,
+	).Scan(&id); err != nil {
+		return 0, err
+	}
+	repository.invalidateTotals()
+	return id, nil
+}
@@ -0,0 +1,55 @@
+package productrepository
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/jackc/pgx/v4"
+)
+
+func (repository repository) CreateManyCopy(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error) {
+	tx, err := repository.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	copied, err := tx.CopyFrom(
+		ctx,
+		repository.table,
+		[]string{"name", "price", "description", "discount_type", "discount_value", "discount_starts_at", "discount_ends_at"},
+		pgx.CopyFromSlice(len(productRequests), func(i int) ([]interface{}, error) {
+			productRequest := productRequests[i]
+			if discount := productRequest.Discount; discount != nil {
+				return []interface{}{
+					productRequest.Name,
+					productRequest.Price,
+					productRequest.Description,
+					discount.Type,
+					discount.Value,
+					discount.StartsAt,
+					discount.EndsAt,
+				}, nil
+			}
+			return []interface{}{
+				productRequest.Name,
+				productRequest.Price,
+				productRequest.Description,
+				nil,
+				nil,
+				nil,
+				nil,
+			}, nil
+		}),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	repository.invalidateTotals()
+
+	return copied, nil
+}
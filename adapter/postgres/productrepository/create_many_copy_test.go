@@ -0,0 +1,81 @@
+package productrepository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestCreateManyCopyIntegration(t *testing.T) {
+	copied := integrationRepository(t, Options{})
+	inserted := integrationRepository(t, Options{})
+	ctx := context.Background()
+
+	startsAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	productRequests := make([]*dto.CreateProductRequest, 0, 5000)
+	for i := 0; i < cap(productRequests); i++ {
+		productRequest := &dto.CreateProductRequest{
+			Name:        fmt.Sprintf("Product %d", i),
+			Price:       float32(i%1000) + 0.5,
+			Description: fmt.Sprintf("Description of product %d", i),
+		}
+		if i%7 == 0 {
+			productRequest.Discount = &dto.DiscountRequest{Type: "percentage", Value: 15, StartsAt: &startsAt}
+		}
+		productRequests = append(productRequests, productRequest)
+	}
+
+	count, err := copied.CreateManyCopy(ctx, productRequests)
+	if err != nil {
+		t.Fatalf("CreateManyCopy() error = %v", err)
+	}
+	if count != int64(len(productRequests)) {
+		t.Fatalf("CreateManyCopy() = %d, want %d", count, len(productRequests))
+	}
+	for _, productRequest := range productRequests {
+		if _, err := inserted.Create(ctx, productRequest); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := allProducts(t, inserted)
+	got := allProducts(t, copied)
+	if len(got) != len(want) {
+		t.Fatalf("copied %d rows, inserted %d", len(got), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Fatalf("row %d copied as %+v, inserted as %+v", i, got[i], want[i])
+		}
+	}
+}
+
+// allProducts reads every row of the repository's table in id order, without
+// updated_at, which differs between two otherwise identical imports.
+func allProducts(t *testing.T, repository repository) []domain.Product {
+	t.Helper()
+	rows, err := repository.db.Query(context.Background(), "SELECT "+productColumns+" FROM "+repository.tableName+" ORDER BY id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	products := []domain.Product{}
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		product.UpdatedAt = time.Time{}
+		products = append(products, *product)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return products
+}
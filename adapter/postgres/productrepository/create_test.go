@@ -0,0 +1,107 @@
+package productrepository
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// The hot statements run as SQL text against the configured table.
+func TestHotStatementsSendSQL(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	productRow := []interface{}{int32(9), "Chair", float32(10), "Oak chair", nil, nil, nil, nil, updatedAt}
+	request := &dto.CreateProductRequest{Name: "Chair", Price: 10, Description: "Oak chair"}
+	tests := []struct {
+		name   string
+		row    []interface{}
+		run    func(repository) error
+		prefix string
+	}{
+		{
+			name: "create",
+			row:  productRow,
+			run: func(repository repository) error {
+				_, err := repository.Create(context.Background(), request)
+				return err
+			},
+			prefix: `INSERT INTO "product" (name, price, description`,
+		},
+		{
+			name: "create id",
+			row:  []interface{}{int32(9)},
+			run: func(repository repository) error {
+				_, err := repository.CreateID(context.Background(), request)
+				return err
+			},
+			prefix: `INSERT INTO "product" (name, price, description`,
+		},
+		{
+			name: "get by id",
+			row:  productRow,
+			run: func(repository repository) error {
+				_, err := repository.GetByID(context.Background(), 9)
+				return err
+			},
+			prefix: `SELECT ` + productColumns + ` FROM "product" WHERE id = $1`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+				return [][]interface{}{test.row}, nil
+			}}
+
+			if err := test.run(newTestRepository(pool, Options{})); err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if !strings.HasPrefix(pool.queries[0].sql, test.prefix) {
+				t.Errorf("sql = %q, want prefix %q", pool.queries[0].sql, test.prefix)
+			}
+		})
+	}
+}
+
+func TestCreateIDReturnsOnlyTheID(t *testing.T) {
+	pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+		return [][]interface{}{{int32(9)}}, nil
+	}}
+	request := &dto.CreateProductRequest{Name: "Chair", Price: 10, Description: "Oak chair"}
+
+	id, err := newTestRepository(pool, Options{}).CreateID(context.Background(), request)
+	if err != nil || id != 9 {
+		t.Fatalf("CreateID() = %d, %v; want 9", id, err)
+	}
+	query := pool.queries[0]
+	if !strings.HasSuffix(query.sql, "returning id") {
+		t.Errorf("sql = %q, want it to return only the id", query.sql)
+	}
+	if !reflect.DeepEqual(query.args, []interface{}{"Chair", float32(10), "Oak chair", (*string)(nil), (*float32)(nil), (*time.Time)(nil), (*time.Time)(nil)}) {
+		t.Errorf("args = %v", query.args)
+	}
+}
+
+func TestCreateReturnsTheStoredRow(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+		return [][]interface{}{{int32(42), "Chair", float32(10), "Oak chair", nil, nil, nil, nil, updatedAt}}, nil
+	}}
+	request := &dto.CreateProductRequest{Name: "Chair", Price: 10, Description: "Oak chair"}
+
+	product, err := newTestRepository(pool, Options{}).Create(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if product.ID == 0 {
+		t.Fatal("Create() returned id 0, want the id generated by the database")
+	}
+	if product.ID != 42 || !product.UpdatedAt.Equal(updatedAt) {
+		t.Errorf("Create() = %+v, want the returned row with id 42 and updated_at %v", product, updatedAt)
+	}
+	if !strings.HasSuffix(pool.queries[0].sql, "returning "+productColumns) {
+		t.Errorf("sql = %q, want it to return the stored row", pool.queries[0].sql)
+	}
+}
@@ -0,0 +1,37 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+)
+
+func (repository repository) Delete(ctx context.Context, id int32) error {
+	commandTag, err := repository.db.Exec(ctx, "DELETE FROM "+repository.tableName+" WHERE id = $1", id)
+	if err != nil {
+		return referencedError(err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return domain.ErrProductNotFound
+	}
+	repository.invalidateTotals()
+
+	return nil
+}
+
+// referencedError turns a foreign key violation raised by deleting a product
+// into domain.ErrProductReferenced, naming the referencing table.
+func referencedError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != pgerrcode.ForeignKeyViolation {
+		return err
+	}
+	if pgErr.TableName == "" {
+		return domain.ErrProductReferenced
+	}
+	return fmt.Errorf("%w by %s", domain.ErrProductReferenced, pgErr.TableName)
+}
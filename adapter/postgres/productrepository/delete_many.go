@@ -0,0 +1,41 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+// DeleteMany deletes each id on its own so one failure does not abort the
+// rest. The returned error is reserved for failures of the whole call.
+func (repository repository) DeleteMany(ctx context.Context, ids []int32) ([]domain.DeleteResult, error) {
+	results := make([]domain.DeleteResult, 0, len(ids))
+	deleted := false
+	for _, id := range ids {
+		result := domain.DeleteResult{ID: id}
+		commandTag, err := repository.db.Exec(ctx, "DELETE FROM "+repository.tableName+" WHERE id = $1", id)
+		err = referencedError(err)
+		switch {
+		case errors.Is(err, domain.ErrProductReferenced):
+			result.Status = domain.DeleteStatusReferenced
+			result.Error = err.Error()
+		case err != nil:
+			logging.FromContext(ctx).Error("Unable to delete product", "id", id, "error", err)
+			result.Status = domain.DeleteStatusError
+			result.Error = err.Error()
+		case commandTag.RowsAffected() == 0:
+			result.Status = domain.DeleteStatusNotFound
+		default:
+			result.Status = domain.DeleteStatusDeleted
+			deleted = true
+		}
+		results = append(results, result)
+	}
+	if deleted {
+		repository.invalidateTotals()
+	}
+
+	return results, nil
+}
@@ -0,0 +1,117 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestDeleteReferenced(t *testing.T) {
+	pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+		return nil, &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation, TableName: "product_price_schedules"}
+	}}
+
+	err := newTestRepository(pool, Options{}).Delete(context.Background(), 9)
+	if !errors.Is(err, domain.ErrProductReferenced) {
+		t.Fatalf("Delete() error = %v, want %v", err, domain.ErrProductReferenced)
+	}
+	if !strings.Contains(err.Error(), "product_price_schedules") {
+		t.Errorf("Delete() error = %q, want it to name the referencing table", err)
+	}
+}
+
+func TestDeleteWithPendingScheduleIntegration(t *testing.T) {
+	repository := integrationRepository(t, Options{})
+	ctx := context.Background()
+
+	product, err := repository.Create(ctx, &dto.CreateProductRequest{Name: "Chair", Price: 10, Description: "Oak chair"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repository.SchedulePrice(ctx, product.ID, &dto.SchedulePriceRequest{NewPrice: 8, EffectiveAt: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repository.Delete(ctx, product.ID); !errors.Is(err, domain.ErrProductReferenced) {
+		t.Fatalf("Delete() with a pending schedule error = %v, want %v", err, domain.ErrProductReferenced)
+	}
+	if _, err := repository.GetByID(ctx, product.ID); err != nil {
+		t.Errorf("GetByID() after the refused delete error = %v", err)
+	}
+
+	if _, err := repository.ApplyDuePriceSchedules(ctx, time.Now().Add(2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := repository.Delete(ctx, product.ID); err != nil {
+		t.Errorf("Delete() once the schedule applied error = %v", err)
+	}
+}
+
+// deletingPool deletes the ids in existing once each and fails the ids in
+// errs; every other id matches no row.
+type deletingPool struct {
+	*fakePool
+	existing map[int32]bool
+	errs     map[int32]error
+}
+
+func (pool *deletingPool) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	pool.answer(sql, args)
+	id := args[0].(int32)
+	if err := pool.errs[id]; err != nil {
+		return nil, err
+	}
+	if pool.existing[id] {
+		delete(pool.existing, id)
+		return pgconn.CommandTag("DELETE 1"), nil
+	}
+	return pgconn.CommandTag("DELETE 0"), nil
+}
+
+func TestDeleteManyReportsEachID(t *testing.T) {
+	pool := &deletingPool{
+		fakePool: &fakePool{},
+		existing: map[int32]bool{1: true, 3: true},
+		errs: map[int32]error{
+			4: &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation, TableName: "product_price_schedules"},
+			5: errors.New("connection reset"),
+		},
+	}
+	repository := newTestRepository(pool.fakePool, Options{})
+	repository.db = pool
+
+	results, err := repository.DeleteMany(context.Background(), []int32{1, 2, 3, 3, 4, 5})
+	if err != nil {
+		t.Fatalf("DeleteMany() error = %v", err)
+	}
+	want := []string{
+		domain.DeleteStatusDeleted,
+		domain.DeleteStatusNotFound,
+		domain.DeleteStatusDeleted,
+		domain.DeleteStatusNotFound,
+		domain.DeleteStatusReferenced,
+		domain.DeleteStatusError,
+	}
+	if len(results) != len(want) {
+		t.Fatalf("DeleteMany() returned %d results, want %d", len(results), len(want))
+	}
+	for i, result := range results {
+		if result.Status != want[i] {
+			t.Errorf("result %d (id %d) status = %q, want %q", i, result.ID, result.Status, want[i])
+		}
+		if hasError := result.Error != ""; hasError != (want[i] == domain.DeleteStatusReferenced || want[i] == domain.DeleteStatusError) {
+			t.Errorf("result %d (id %d) error = %q", i, result.ID, result.Error)
+		}
+	}
+	if len(pool.queries) != 6 {
+		t.Errorf("ran %d deletes, want one per id", len(pool.queries))
+	}
+}
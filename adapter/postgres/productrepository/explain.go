@@ -0,0 +1,73 @@
+package productrepository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+const (
+	ExplainFormatJSON = "json"
+	ExplainFormatText = "text"
+)
+
+// Explainer reports how Postgres executes the listing query for a set of
+// filters. It is kept off domain.ProductRepository because it only serves
+// the admin debugging endpoint.
+type Explainer interface {
+	ExplainFetch(ctx context.Context, pagination *dto.PaginationRequestParams, format string, analyze bool) ([]byte, error)
+}
+
+func NewExplainer(db postgres.PoolInterface, options Options) Explainer {
+	return New(db, options).(*repository)
+}
+
+// ExplainFetch runs EXPLAIN on the page query Fetch would run for
+// pagination. With analyze, it runs EXPLAIN (ANALYZE, BUFFERS), which
+// executes the query, so it always runs inside a read-only transaction to
+// guarantee nothing can be written.
+func (repository repository) ExplainFetch(ctx context.Context, pagination *dto.PaginationRequestParams, format string, analyze bool) ([]byte, error) {
+	if format != ExplainFormatJSON && format != ExplainFormatText {
+		return nil, fmt.Errorf("%w: invalid explain format %q: expected %s or %s", domain.ErrValidation, format, ExplainFormatJSON, ExplainFormatText)
+	}
+	if err := pagination.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+	if err := repository.checkOffset(pagination.Page, pagination.ItemsPerPage); err != nil {
+		return nil, err
+	}
+	query, _, args := repository.fetchQuery(pagination)
+
+	options := "FORMAT " + strings.ToUpper(format)
+	if analyze {
+		options = "ANALYZE, BUFFERS, " + options
+	}
+
+	lines := []string{}
+	err := repository.db.BeginTxFunc(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, "EXPLAIN ("+options+") "+query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var line string
+			if err := rows.Scan(&line); err != nil {
+				return err
+			}
+			lines = append(lines, line)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
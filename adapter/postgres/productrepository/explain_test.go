@@ -0,0 +1,67 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestExplainFetch(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		analyze bool
+		prefix  string
+	}{
+		{name: "plan only", format: ExplainFormatJSON, prefix: "EXPLAIN (FORMAT JSON) SELECT "},
+		{name: "text", format: ExplainFormatText, prefix: "EXPLAIN (FORMAT TEXT) SELECT "},
+		{name: "analyze", format: ExplainFormatJSON, analyze: true, prefix: "EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON) SELECT "},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+				return [][]interface{}{{"Limit"}, {"  ->  Sort"}}, nil
+			}}
+			pagination := &dto.PaginationRequestParams{Search: "'); DELETE FROM product; --", Page: 1, ItemsPerPage: 10}
+
+			plan, err := newTestRepository(pool, Options{}).ExplainFetch(context.Background(), pagination, test.format, test.analyze)
+			if err != nil {
+				t.Fatalf("ExplainFetch() error = %v", err)
+			}
+			if string(plan) != "Limit\n  ->  Sort" {
+				t.Errorf("plan = %q", plan)
+			}
+			if !reflect.DeepEqual(pool.txOptions, []pgx.TxOptions{{AccessMode: pgx.ReadOnly}}) {
+				t.Errorf("transactions = %+v, want one read-only transaction", pool.txOptions)
+			}
+			query := pool.queries[0]
+			if !strings.HasPrefix(query.sql, test.prefix) {
+				t.Errorf("sql = %q, want prefix %q", query.sql, test.prefix)
+			}
+			if strings.Contains(query.sql, "DELETE") {
+				t.Errorf("sql %q contains the search term", query.sql)
+			}
+			if !reflect.DeepEqual(query.args, []interface{}{pagination.Search}) {
+				t.Errorf("args = %v, want the search term", query.args)
+			}
+		})
+	}
+}
+
+func TestExplainFetchRejectsUnknownFormat(t *testing.T) {
+	pool := &fakePool{}
+	_, err := newTestRepository(pool, Options{}).ExplainFetch(context.Background(), dto.DefaultPaginationRequestParams(), "yaml", false)
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("ExplainFetch() error = %v, want a validation error", err)
+	}
+	if len(pool.queries) != 0 {
+		t.Errorf("ran %d queries, want none", len(pool.queries))
+	}
+}
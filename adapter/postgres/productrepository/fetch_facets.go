@@ -0,0 +1,58 @@
+package productrepository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (repository repository) FetchFacets(ctx context.Context, search string) (*domain.ProductFacets, error) {
+
+	builder := &queryBuilder{}
+	if search != "" {
+		term := builder.arg(search)
+		builder.where(fmt.Sprintf("(STRPOS(LOWER(name), LOWER(%s)) > 0 OR STRPOS(LOWER(description), LOWER(%s)) > 0)", term, term))
+	}
+
+	cases := make([]string, 0, len(domain.PriceFacetBounds))
+	for i, bound := range domain.PriceFacetBounds {
+		cases = append(cases, fmt.Sprintf("WHEN price < %s THEN %d", builder.arg(bound), i))
+	}
+	query := fmt.Sprintf(
+		"SELECT CASE %s ELSE %d END AS bucket, COUNT(id) FROM %s%s GROUP BY bucket",
+		strings.Join(cases, " "),
+		len(domain.PriceFacetBounds),
+		repository.tableName,
+		builder.whereClause(),
+	)
+
+	facets := &domain.ProductFacets{PriceRanges: priceFacets()}
+	rows, err := repository.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		bucket, count := int32(0), int32(0)
+		if err := rows.Scan(&bucket, &count); err != nil {
+			return nil, err
+		}
+		facets.PriceRanges[bucket].Count = count
+	}
+
+	return facets, rows.Err()
+}
+
+func priceFacets() []domain.PriceFacet {
+	facets := make([]domain.PriceFacet, 0, len(domain.PriceFacetBounds)+1)
+	lower := float32(0)
+	for i := range domain.PriceFacetBounds {
+		upper := domain.PriceFacetBounds[i]
+		facets = append(facets, domain.PriceFacet{Min: lower, Max: &upper})
+		lower = upper
+	}
+	return append(facets, domain.PriceFacet{Min: lower})
+}
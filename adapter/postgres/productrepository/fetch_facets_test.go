@@ -0,0 +1,72 @@
+package productrepository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestFetchFacetsFillsEmptyBuckets(t *testing.T) {
+	pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+		return [][]interface{}{{int32(0), int32(3)}, {int32(4), int32(1)}}, nil
+	}}
+
+	facets, err := newTestRepository(pool, Options{}).FetchFacets(context.Background(), "oak")
+	if err != nil {
+		t.Fatalf("FetchFacets() error = %v", err)
+	}
+	counts := make([]int32, 0, len(facets.PriceRanges))
+	for _, facet := range facets.PriceRanges {
+		counts = append(counts, facet.Count)
+	}
+	if want := []int32{3, 0, 0, 0, 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("price range counts = %v, want %v", counts, want)
+	}
+	if args := pool.queries[0].args; len(args) == 0 || args[0] != "oak" {
+		t.Errorf("args = %v, want the search term first", args)
+	}
+}
+
+func TestFetchFacetsIntegration(t *testing.T) {
+	repository := integrationRepository(t, Options{})
+	ctx := context.Background()
+
+	for _, request := range []dto.CreateProductRequest{
+		{Name: "Pencil", Price: 2, Description: "Graphite pencil"},
+		{Name: "Notebook", Price: 9.99, Description: "Ruled notebook"},
+		{Name: "Stool", Price: 10, Description: "Oak stool"},
+		{Name: "Chair", Price: 45, Description: "Oak chair"},
+		{Name: "Desk", Price: 250, Description: "Oak desk"},
+		{Name: "Wardrobe", Price: 900, Description: "Pine wardrobe"},
+	} {
+		if _, err := repository.Create(ctx, &request); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		search     string
+		wantCounts []int32
+	}{
+		{search: "", wantCounts: []int32{2, 2, 0, 1, 1}},
+		{search: "oak", wantCounts: []int32{0, 2, 0, 1, 0}},
+		{search: "marble", wantCounts: []int32{0, 0, 0, 0, 0}},
+	}
+	for _, test := range tests {
+		t.Run("search "+test.search, func(t *testing.T) {
+			facets, err := repository.FetchFacets(ctx, test.search)
+			if err != nil {
+				t.Fatalf("FetchFacets() error = %v", err)
+			}
+			counts := make([]int32, 0, len(facets.PriceRanges))
+			for _, facet := range facets.PriceRanges {
+				counts = append(counts, facet.Count)
+			}
+			if !reflect.DeepEqual(counts, test.wantCounts) {
+				t.Errorf("price range counts = %v, want %v", counts, test.wantCounts)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package productrepository
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// FetchIDs returns the id of every product matching the Fetch filters,
+// ignoring page, size and sort.
+func (repository repository) FetchIDs(ctx context.Context, pagination *dto.PaginationRequestParams) ([]int32, error) {
+	ids := []int32{}
+
+	builder := fetchConditions(pagination)
+	query := "SELECT id FROM " + repository.tableName + builder.whereClause() + " ORDER BY id"
+	logging.FromContext(ctx).Debug("Fetching product ids", "sql", query)
+
+	rows, err := repository.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		id := int32(0)
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
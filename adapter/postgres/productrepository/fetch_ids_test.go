@@ -0,0 +1,60 @@
+package productrepository
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestFetchIDsBindsFilters(t *testing.T) {
+	tests := []struct {
+		name       string
+		pagination dto.PaginationRequestParams
+		where      string
+		args       []interface{}
+	}{
+		{
+			name: "unfiltered",
+		},
+		{
+			name:       "search",
+			pagination: dto.PaginationRequestParams{Search: "x') OR 1=1 --"},
+			where:      " WHERE (STRPOS(LOWER(name), LOWER($1)) > 0 OR STRPOS(LOWER(description), LOWER($1)) > 0)",
+			args:       []interface{}{"x') OR 1=1 --"},
+		},
+		{
+			name:       "name and search",
+			pagination: dto.PaginationRequestParams{Name: "Chair", NameCaseInsensitive: true, Search: "oak"},
+			where:      " WHERE LOWER(name) = LOWER($1) AND (STRPOS(LOWER(name), LOWER($2)) > 0 OR STRPOS(LOWER(description), LOWER($2)) > 0)",
+			args:       []interface{}{"Chair", "oak"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+				return [][]interface{}{{int32(3)}, {int32(7)}}, nil
+			}}
+
+			ids, err := newTestRepository(pool, Options{}).FetchIDs(context.Background(), &test.pagination)
+			if err != nil {
+				t.Fatalf("FetchIDs() error = %v", err)
+			}
+			if !reflect.DeepEqual(ids, []int32{3, 7}) {
+				t.Errorf("FetchIDs() = %v, want [3 7]", ids)
+			}
+			query := pool.queries[0]
+			if want := "SELECT id FROM \"product\"" + test.where + " ORDER BY id"; query.sql != want {
+				t.Errorf("sql = %q, want %q", query.sql, want)
+			}
+			if test.pagination.Search != "" && strings.Contains(query.sql, test.pagination.Search) {
+				t.Errorf("sql %q contains the search term", query.sql)
+			}
+			if !reflect.DeepEqual(query.args, test.args) {
+				t.Errorf("args = %v, want %v", query.args, test.args)
+			}
+		})
+	}
+}
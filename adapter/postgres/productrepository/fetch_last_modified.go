@@ -0,0 +1,27 @@
+package productrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// FetchLastModified returns the latest change to the products matching the
+// Fetch filters. A deleted product only leaves its tombstone behind, so the
+// last delete anywhere in the table counts as a change to every listing. It
+// is nil when the table has never held a product.
+func (repository repository) FetchLastModified(ctx context.Context, pagination *dto.PaginationRequestParams) (*time.Time, error) {
+	builder := fetchConditions(pagination)
+	query := "SELECT GREATEST(" +
+		"(SELECT MAX(updated_at) FROM " + repository.tableName + builder.whereClause() + "), " +
+		"(SELECT MAX(deleted_at) FROM " + repository.companionTable("tombstones") + "))"
+	logging.FromContext(ctx).Debug("Fetching products last modified", "sql", query)
+
+	var lastModified *time.Time
+	if err := repository.db.QueryRow(ctx, query, builder.args...).Scan(&lastModified); err != nil {
+		return nil, err
+	}
+	return lastModified, nil
+}
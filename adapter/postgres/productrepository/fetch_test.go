@@ -0,0 +1,161 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestFetchQuery(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	pool := &fakePool{respond: func(sql string, args []interface{}) ([][]interface{}, error) {
+		if strings.HasPrefix(sql, "SELECT COUNT") {
+			return [][]interface{}{{int32(1)}}, nil
+		}
+		return [][]interface{}{{int32(4), "Chair", float32(10), "Oak chair", nil, nil, nil, nil, updatedAt}}, nil
+	}}
+	pagination := &dto.PaginationRequestParams{
+		Name:         "Chair",
+		Search:       "oak",
+		Sort:         []string{"price"},
+		Descending:   []string{"true"},
+		Page:         2,
+		ItemsPerPage: 5,
+	}
+
+	products, err := newTestRepository(pool, Options{}).Fetch(context.Background(), pagination)
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if len(products.Items) != 1 || products.Items[0].ID != 4 || products.Total != 1 {
+		t.Errorf("Fetch() = %+v", products)
+	}
+
+	where := ` WHERE name = $1 AND (STRPOS(LOWER(name), LOWER($2)) > 0 OR STRPOS(LOWER(description), LOWER($2)) > 0)`
+	args := []interface{}{"Chair", "oak"}
+	want := []recordedQuery{
+		{sql: `SELECT ` + productColumns + ` FROM "product"` + where + ` ORDER BY price DESC, id ASC LIMIT 5 OFFSET 5`, args: args},
+		{sql: `SELECT COUNT(id) FROM "product"` + where, args: args},
+	}
+	if !reflect.DeepEqual(pool.queries, want) {
+		t.Errorf("queries = %+v, want %+v", pool.queries, want)
+	}
+}
+
+func TestFetchReportsRowsError(t *testing.T) {
+	rowsErr := errors.New("connection reset")
+	pool := &fakePool{rowsErr: rowsErr}
+
+	_, err := newTestRepository(pool, Options{}).Fetch(context.Background(), dto.DefaultPaginationRequestParams())
+	if !errors.Is(err, rowsErr) {
+		t.Errorf("Fetch() error = %v, want %v", err, rowsErr)
+	}
+}
+
+// BenchmarkFetch measures scanning a full page from the pool. The fake pool
+// scans by reflection, so compare results between runs rather than against
+// a real database.
+func BenchmarkFetch(b *testing.B) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	for _, itemsPerPage := range []int{10, 50, dto.MaxItemsPerPage} {
+		rows := make([][]interface{}, itemsPerPage)
+		for i := range rows {
+			rows[i] = []interface{}{int32(i + 1), "Chair", float32(10), "Oak chair", nil, nil, nil, nil, updatedAt}
+		}
+		pool := &fakePool{respond: func(sql string, args []interface{}) ([][]interface{}, error) {
+			if strings.HasPrefix(sql, "SELECT COUNT") {
+				return [][]interface{}{{int32(itemsPerPage)}}, nil
+			}
+			return rows, nil
+		}}
+		repository := newTestRepository(pool, Options{})
+		pagination := &dto.PaginationRequestParams{Page: 1, ItemsPerPage: itemsPerPage}
+
+		b.Run(fmt.Sprintf("itemsPerPage=%d", itemsPerPage), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				pool.queries = pool.queries[:0]
+				if _, err := repository.Fetch(context.Background(), pagination); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchDefaultSort(t *testing.T) {
+	tests := []struct {
+		defaultSort string
+		wantOrder   string
+	}{
+		{defaultSort: "", wantOrder: "ORDER BY id ASC"},
+		{defaultSort: "price desc", wantOrder: "ORDER BY price DESC, id ASC"},
+		{defaultSort: "name", wantOrder: "ORDER BY name ASC, id ASC"},
+		{defaultSort: "discount_value desc", wantOrder: "ORDER BY id ASC"},
+	}
+	for _, test := range tests {
+		t.Run("default sort "+test.defaultSort, func(t *testing.T) {
+			pool := &fakePool{respond: func(sql string, args []interface{}) ([][]interface{}, error) {
+				if strings.HasPrefix(sql, "SELECT COUNT") {
+					return [][]interface{}{{int32(0)}}, nil
+				}
+				return nil, nil
+			}}
+
+			if _, err := newTestRepository(pool, Options{DefaultSort: test.defaultSort}).Fetch(context.Background(), dto.DefaultPaginationRequestParams()); err != nil {
+				t.Fatalf("Fetch() error = %v", err)
+			}
+			if !strings.Contains(pool.queries[0].sql, test.wantOrder+" LIMIT") {
+				t.Errorf("sql = %q, want %q", pool.queries[0].sql, test.wantOrder)
+			}
+		})
+	}
+}
+
+func TestFetchPagesDoNotOverlapIntegration(t *testing.T) {
+	repository := integrationRepository(t, Options{DefaultSort: "price desc"})
+	ctx := context.Background()
+
+	const products = 25
+	for i := 0; i < products; i++ {
+		// Only three distinct prices, so the order within a price is left to
+		// the id tiebreaker.
+		request := dto.CreateProductRequest{Name: fmt.Sprintf("Product %d", i), Price: float32(10 * (i%3 + 1)), Description: "Seeded"}
+		if _, err := repository.Create(ctx, &request); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	seen := map[int32]int{}
+	previousPrice := float32(0)
+	for page := 1; page <= 3; page++ {
+		pagination := &dto.PaginationRequestParams{Page: page, ItemsPerPage: 10}
+		pagination.Normalize()
+		result, err := repository.Fetch(ctx, pagination)
+		if err != nil {
+			t.Fatalf("Fetch() page %d error = %v", page, err)
+		}
+		for _, product := range result.Items {
+			if previousPrice != 0 && product.Price > previousPrice {
+				t.Errorf("product %d at %v follows a cheaper product, want price descending", product.ID, product.Price)
+			}
+			previousPrice = product.Price
+			seen[product.ID]++
+		}
+	}
+
+	if len(seen) != products {
+		t.Errorf("pages returned %d distinct products, want %d", len(seen), products)
+	}
+	for id, count := range seen {
+		if count != 1 {
+			t.Errorf("product %d returned on %d pages, want 1", id, count)
+		}
+	}
+}
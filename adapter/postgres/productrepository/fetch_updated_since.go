@@ -0,0 +1,69 @@
+package productrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// FetchUpdatedSince pages through products changed after since, oldest change
+// first, including tombstones for products deleted in that window. Sort and
+// filters from pagination are ignored; only the page and size are used.
+func (repository repository) FetchUpdatedSince(ctx context.Context, since time.Time, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	if err := repository.checkOffset(pagination.Page, pagination.ItemsPerPage); err != nil {
+		return nil, err
+	}
+	products := make([]domain.Product, 0, pagination.ItemsPerPage)
+
+	tombstones := repository.companionTable("tombstones")
+	query := `SELECT ` + productColumns + `, FALSE FROM ` + repository.tableName + ` WHERE updated_at > $1
+		UNION ALL
+		SELECT product_id, '', 0, '', NULL, NULL, NULL, NULL, deleted_at, TRUE FROM ` + tombstones + ` WHERE deleted_at > $1
+		ORDER BY updated_at, id
+		LIMIT $2 OFFSET $3`
+	logging.FromContext(ctx).Debug("Fetching products updated since", "sql", query, "since", since)
+
+	{
+		rows, err := repository.db.Query(ctx, query, since, pagination.ItemsPerPage, (pagination.Page-1)*pagination.ItemsPerPage)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			deleted := false
+			product, err := scanProduct(rows, &deleted)
+			if err != nil {
+				return nil, err
+			}
+			if deleted {
+				product.Deleted = true
+				product.Discount = nil
+			}
+			products = append(products, *product)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	total := int32(0)
+	err := repository.db.QueryRow(
+		ctx,
+		`SELECT (SELECT COUNT(id) FROM `+repository.tableName+` WHERE updated_at > $1)
+			+ (SELECT COUNT(id) FROM `+tombstones+` WHERE deleted_at > $1)`,
+		since,
+	).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Pagination[[]domain.Product]{
+		Items:         products,
+		Total:         total,
+		CountStrategy: domain.CountStrategyExact,
+	}, nil
+}
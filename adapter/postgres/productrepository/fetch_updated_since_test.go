@@ -0,0 +1,45 @@
+package productrepository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestFetchUpdatedSinceReturnsUpdatesAndDeletes(t *testing.T) {
+	since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	pool := &fakePool{respond: func(sql string, args []interface{}) ([][]interface{}, error) {
+		if strings.Contains(sql, "COUNT(id)") {
+			return [][]interface{}{{int32(2)}}, nil
+		}
+		return [][]interface{}{
+			{int32(1), "Chair", float32(10), "Oak chair", "fixed", float32(2), nil, nil, since.Add(time.Hour), false},
+			{int32(2), "", float32(0), "", nil, nil, nil, nil, since.Add(2 * time.Hour), true},
+		}, nil
+	}}
+
+	products, err := newTestRepository(pool, Options{Schema: "catalog"}).FetchUpdatedSince(context.Background(), since, dto.DefaultPaginationRequestParams())
+	if err != nil {
+		t.Fatalf("FetchUpdatedSince() error = %v", err)
+	}
+	if products.Total != 2 || len(products.Items) != 2 {
+		t.Fatalf("FetchUpdatedSince() = %+v, want two changes", products)
+	}
+	if updated := products.Items[0]; updated.Deleted || updated.Name != "Chair" || updated.Discount == nil {
+		t.Errorf("updated product = %+v", updated)
+	}
+	if deleted := products.Items[1]; !deleted.Deleted || deleted.ID != 2 {
+		t.Errorf("deleted product = %+v, want a tombstone for id 2", deleted)
+	}
+	for _, query := range pool.queries {
+		if !strings.Contains(query.sql, `"catalog"."product_tombstones"`) {
+			t.Errorf("sql %q does not read the schema's tombstones", query.sql)
+		}
+		if query.args[0] != since {
+			t.Errorf("args = %v, want since first", query.args)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package productrepository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+var filterComparisons = map[string]string{
+	"eq":  "=",
+	"ne":  "<>",
+	"lt":  "<",
+	"lte": "<=",
+	"gt":  ">",
+	"gte": ">=",
+}
+
+func (builder *queryBuilder) compileFilter(node *dto.FilterNode) string {
+	if len(node.And) > 0 {
+		return builder.compileGroup(node.And, " AND ")
+	}
+	if len(node.Or) > 0 {
+		return builder.compileGroup(node.Or, " OR ")
+	}
+
+	if node.Op == "contains" {
+		return fmt.Sprintf("STRPOS(LOWER(%s), LOWER(%s)) > 0", node.Field, builder.arg(node.Value))
+	}
+	return fmt.Sprintf("%s %s %s", node.Field, filterComparisons[node.Op], builder.arg(node.Value))
+}
+
+func (builder *queryBuilder) compileGroup(nodes []dto.FilterNode, operator string) string {
+	conditions := make([]string, 0, len(nodes))
+	for i := range nodes {
+		conditions = append(conditions, builder.compileFilter(&nodes[i]))
+	}
+	return "(" + strings.Join(conditions, operator) + ")"
+}
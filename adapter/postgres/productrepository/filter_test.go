@@ -0,0 +1,29 @@
+package productrepository
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestCompileFilterGroupsMixedAndOr(t *testing.T) {
+	// (name contains "x" OR description = "y") AND price < 100
+	filter := &dto.FilterNode{And: []dto.FilterNode{
+		{Or: []dto.FilterNode{
+			{Field: "name", Op: "contains", Value: "x"},
+			{Field: "description", Op: "eq", Value: "y"},
+		}},
+		{Field: "price", Op: "lt", Value: float64(100)},
+	}}
+	builder := &queryBuilder{}
+
+	where := builder.compileFilter(filter)
+
+	if want := "((STRPOS(LOWER(name), LOWER($1)) > 0 OR description = $2) AND price < $3)"; where != want {
+		t.Errorf("compileFilter() = %q, want %q", where, want)
+	}
+	if want := []interface{}{"x", "y", float64(100)}; !reflect.DeepEqual(builder.args, want) {
+		t.Errorf("args = %v, want %v", builder.args, want)
+	}
+}
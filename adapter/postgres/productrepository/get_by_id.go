@@ -0,0 +1,26 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/jackc/pgx/v4"
+)
+
+func (repository repository) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	product, err := scanProduct(repository.db.QueryRow(
+		ctx,
+		"SELECT "+productColumns+" FROM "+repository.tableName+" WHERE id = $1",
+		id,
+	))
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
@@ -0,0 +1,32 @@
+package productrepository
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+// GetByIDs returns the products with ids in the order the ids are given.
+// Unknown ids are left out.
+func (repository repository) GetByIDs(ctx context.Context, ids []int32) ([]domain.Product, error) {
+	rows, err := repository.db.Query(
+		ctx,
+		"SELECT "+productColumns+" FROM "+repository.tableName+" WHERE id = ANY($1) ORDER BY array_position($1, id)",
+		ids,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	products := make([]domain.Product, 0, len(ids))
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, *product)
+	}
+
+	return products, rows.Err()
+}
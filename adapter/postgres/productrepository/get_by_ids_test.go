@@ -0,0 +1,31 @@
+package productrepository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestGetByIDsKeepsTheRequestedOrderIntegration(t *testing.T) {
+	repository := integrationRepository(t, Options{})
+	ctx := context.Background()
+	for _, name := range []string{"Chair", "Table", "Lamp"} {
+		if _, err := repository.Create(ctx, &dto.CreateProductRequest{Name: name, Price: 10, Description: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	products, err := repository.GetByIDs(ctx, []int32{3, 99, 1, 2})
+	if err != nil {
+		t.Fatalf("GetByIDs() error = %v", err)
+	}
+	var ids []int32
+	for _, product := range products {
+		ids = append(ids, product.ID)
+	}
+	if want := []int32{3, 1, 2}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("GetByIDs() ids = %v, want %v", ids, want)
+	}
+}
@@ -0,0 +1,31 @@
+package productrepository
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (repository repository) GetPriceHistory(ctx context.Context, productID int32) ([]domain.PriceChange, error) {
+	history := []domain.PriceChange{}
+
+	rows, err := repository.db.Query(
+		ctx,
+		"SELECT price, changed_at FROM "+repository.companionTable("price_history")+" WHERE product_id = $1 ORDER BY changed_at DESC, id DESC",
+		productID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change := domain.PriceChange{}
+		if err := rows.Scan(&change.Price, &change.ChangedAt); err != nil {
+			return nil, err
+		}
+		history = append(history, change)
+	}
+
+	return history, rows.Err()
+}
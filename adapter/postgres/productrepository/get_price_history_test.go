@@ -0,0 +1,26 @@
+package productrepository
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGetPriceHistoryQualifiesHistoryTable(t *testing.T) {
+	changedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+		return [][]interface{}{{float32(12), changedAt}, {float32(10), changedAt.Add(-time.Hour)}}, nil
+	}}
+
+	history, err := newTestRepository(pool, Options{Schema: "public"}).GetPriceHistory(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GetPriceHistory() error = %v", err)
+	}
+	if len(history) != 2 || history[0].Price != 12 || !history[0].ChangedAt.Equal(changedAt) {
+		t.Errorf("GetPriceHistory() = %+v, want newest change first", history)
+	}
+	want := `SELECT price, changed_at FROM "public"."product_price_history" WHERE product_id = $1 ORDER BY changed_at DESC, id DESC`
+	if pool.queries[0].sql != want {
+		t.Errorf("sql = %q, want %q", pool.queries[0].sql, want)
+	}
+}
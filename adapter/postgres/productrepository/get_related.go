@@ -0,0 +1,36 @@
+package productrepository
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (repository repository) GetRelated(ctx context.Context, id int32, limit int) ([]domain.Product, error) {
+	products := []domain.Product{}
+
+	rows, err := repository.db.Query(
+		ctx,
+		`WITH target AS (SELECT price AS target_price FROM `+repository.tableName+` WHERE id = $1)
+		SELECT `+productColumns+` FROM `+repository.tableName+`, target
+		WHERE id <> $1
+		ORDER BY ABS(price - target_price), id
+		LIMIT $2`,
+		id,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, *product)
+	}
+
+	return products, rows.Err()
+}
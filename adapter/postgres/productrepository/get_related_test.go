@@ -0,0 +1,45 @@
+package productrepository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestGetRelatedIntegration(t *testing.T) {
+	repository := integrationRepository(t, Options{})
+	ctx := context.Background()
+
+	ids := map[string]int32{}
+	for _, request := range []dto.CreateProductRequest{
+		{Name: "Target", Price: 50, Description: "Target"},
+		{Name: "Far", Price: 90, Description: "Far"},
+		{Name: "Near", Price: 52, Description: "Near"},
+		{Name: "Closer", Price: 49, Description: "Closer"},
+		{Name: "Middle", Price: 40, Description: "Middle"},
+	} {
+		product, err := repository.Create(ctx, &request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[product.Name] = product.ID
+	}
+
+	related, err := repository.GetRelated(ctx, ids["Target"], 3)
+	if err != nil {
+		t.Fatalf("GetRelated() error = %v", err)
+	}
+	want := []string{"Closer", "Near", "Middle"}
+	if len(related) != len(want) {
+		t.Fatalf("GetRelated() returned %d products, want %d", len(related), len(want))
+	}
+	for i, product := range related {
+		if product.Name != want[i] {
+			t.Errorf("related[%d] = %q, want %q", i, product.Name, want[i])
+		}
+		if product.ID == ids["Target"] {
+			t.Errorf("related[%d] is the target product", i)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package productrepository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+)
+
+// integrationRepository migrates a fresh schema in the database at
+// TEST_DATABASE_URL, a postgres:// URL, and returns a repository over it.
+// The test is skipped when the variable is unset, and the schema is dropped
+// when it ends.
+func integrationRepository(t *testing.T, options Options) repository {
+	t.Helper()
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	options.Schema = fmt.Sprintf("test_%d", time.Now().UnixNano())
+	schema := pgx.Identifier{options.Schema}.Sanitize()
+
+	migrationsDir := filepath.Join("..", "..", "..", postgres.MigrationsDir)
+	table := postgres.TableIdentifier(options.Schema, options.Table)
+	if err := postgres.RunMigrations(ctx, strings.TrimPrefix(databaseURL, "postgres"), migrationsDir, table); err != nil {
+		t.Fatalf("RunMigrations() error = %v", err)
+	}
+	pool, err := pgxpool.Connect(ctx, databaseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := pool.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			t.Errorf("dropping %s: %v", schema, err)
+		}
+		pool.Close()
+	})
+	return *New(pool, options).(*repository)
+}
@@ -1,16 +1,91 @@
 package productrepository
 
 import (
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/cache"
 	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
 	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
 )
 
+const (
+	defaultCountCap  = 10000
+	defaultMaxOffset = 100000
+	defaultSort      = "id"
+)
+
+type Options struct {
+	CountStrategy string
+	CountCap      int32
+	MaxOffset     int
+	DefaultSort   string
+	Schema        string
+	Table         string
+	TotalCache    cache.Store
+	TotalCacheTTL time.Duration
+}
+
 type repository struct {
-	db postgres.PoolInterface
+	db                postgres.PoolInterface
+	options           Options
+	defaultSort       string
+	defaultDescending string
+	table             pgx.Identifier
+	tableName         string
 }
 
-func New(db postgres.PoolInterface) domain.ProductRepository {
+func New(db postgres.PoolInterface, options Options) domain.ProductRepository {
+	switch options.CountStrategy {
+	case domain.CountStrategyEstimate, domain.CountStrategyCap:
+	default:
+		options.CountStrategy = domain.CountStrategyExact
+	}
+	if options.CountCap <= 0 {
+		options.CountCap = defaultCountCap
+	}
+	if options.MaxOffset <= 0 {
+		options.MaxOffset = defaultMaxOffset
+	}
+
+	sort, descending := parseDefaultSort(options.DefaultSort)
+	table := postgres.TableIdentifier(options.Schema, options.Table)
+	tableName := table.Sanitize()
+
 	return &repository{
-		db: db,
+		db:                db,
+		options:           options,
+		defaultSort:       sort,
+		defaultDescending: descending,
+		table:             table,
+		tableName:         tableName,
+	}
+}
+
+// companionTable qualifies a table created next to the product table by the
+// migrations, such as product_tombstones, with the configured schema.
+func (repository repository) companionTable(name string) string {
+	return postgres.CompanionTable(repository.table, name).Sanitize()
+}
+
+func parseDefaultSort(value string) (string, string) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return defaultSort, "false"
+	}
+
+	sort := fields[0]
+	if !slices.Contains(dto.SortableProductFields, sort) {
+		slog.Warn("Ignoring default sort column outside the allowlist", "sort", sort)
+		return defaultSort, "false"
+	}
+	if len(fields) > 1 && strings.EqualFold(fields[1], "desc") {
+		return sort, "true"
 	}
+	return sort, "false"
 }
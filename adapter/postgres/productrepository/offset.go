@@ -0,0 +1,20 @@
+package productrepository
+
+import (
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+// checkOffset rejects pages whose offset would force the database to scan
+// past more than MaxOffset rows. It divides instead of multiplying, so a huge
+// page cannot overflow page*itemsPerPage into a small or negative offset.
+func (repository repository) checkOffset(page int, itemsPerPage int) error {
+	if itemsPerPage <= 0 || page <= repository.options.MaxOffset/itemsPerPage {
+		return nil
+	}
+	return fmt.Errorf(
+		"%w: page %d with %d items per page exceeds the maximum offset of %d; use cursor pagination instead of paging deeper",
+		domain.ErrValidation, page, itemsPerPage, repository.options.MaxOffset,
+	)
+}
@@ -0,0 +1,45 @@
+package productrepository
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func TestCheckOffset(t *testing.T) {
+	repository := newTestRepository(&fakePool{}, Options{MaxOffset: 1000})
+	maxOffset := repository.options.MaxOffset
+	tests := []struct {
+		name         string
+		page         int
+		itemsPerPage int
+		wantErr      bool
+	}{
+		{name: "boundary", page: maxOffset / 10, itemsPerPage: 10},
+		{name: "just over boundary", page: maxOffset/10 + 1, itemsPerPage: 10, wantErr: true},
+		{name: "misaligned boundary", page: maxOffset / 7, itemsPerPage: 7},
+		{name: "misaligned just over boundary", page: maxOffset/7 + 1, itemsPerPage: 7, wantErr: true},
+		{name: "overflowing page", page: math.MaxInt/10 + 1, itemsPerPage: 10, wantErr: true},
+		{name: "overflowing page and size", page: math.MaxInt, itemsPerPage: math.MaxInt, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := repository.checkOffset(test.page, test.itemsPerPage)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("checkOffset(%d, %d) error = %v, want error %v", test.page, test.itemsPerPage, err, test.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("error = %v, want %v", err, domain.ErrValidation)
+			}
+			if !strings.Contains(err.Error(), "cursor pagination") {
+				t.Errorf("error = %q, want a cursor pagination suggestion", err)
+			}
+		})
+	}
+}
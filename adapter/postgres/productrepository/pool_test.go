@@ -0,0 +1,149 @@
+package productrepository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgproto3/v2"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+)
+
+type recordedQuery struct {
+	sql  string
+	args []interface{}
+}
+
+// fakePool records every statement and answers it with respond, which
+// returns the rows for a query or the error to fail it with. rowsErr is
+// reported by Rows.Err once the rows are read, and commandTag is returned by
+// every Exec.
+type fakePool struct {
+	postgres.PoolInterface
+	respond    func(sql string, args []interface{}) ([][]interface{}, error)
+	rowsErr    error
+	commandTag pgconn.CommandTag
+	queries    []recordedQuery
+	txOptions  []pgx.TxOptions
+}
+
+func (pool *fakePool) answer(sql string, args []interface{}) ([][]interface{}, error) {
+	pool.queries = append(pool.queries, recordedQuery{sql: sql, args: args})
+	if pool.respond == nil {
+		return nil, nil
+	}
+	return pool.respond(sql, args)
+}
+
+func (pool *fakePool) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	_, err := pool.answer(sql, args)
+	return pool.commandTag, err
+}
+
+func (pool *fakePool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	values, err := pool.answer(sql, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{values: values, index: -1, err: pool.rowsErr}, nil
+}
+
+func (pool *fakePool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	values, err := pool.answer(sql, args)
+	return fakeRow{values: values, err: err}
+}
+
+func (pool *fakePool) BeginTxFunc(ctx context.Context, txOptions pgx.TxOptions, f func(pgx.Tx) error) error {
+	pool.txOptions = append(pool.txOptions, txOptions)
+	return f(fakeTx{pool: pool})
+}
+
+func (pool *fakePool) BeginFunc(ctx context.Context, f func(pgx.Tx) error) error {
+	return pool.BeginTxFunc(ctx, pgx.TxOptions{}, f)
+}
+
+type fakeTx struct {
+	pgx.Tx
+	pool *fakePool
+}
+
+func (tx fakeTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return tx.pool.Exec(ctx, sql, args...)
+}
+
+func (tx fakeTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return tx.pool.Query(ctx, sql, args...)
+}
+
+func (tx fakeTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return tx.pool.QueryRow(ctx, sql, args...)
+}
+
+type fakeRow struct {
+	values [][]interface{}
+	err    error
+}
+
+func (row fakeRow) Scan(dest ...interface{}) error {
+	if row.err != nil {
+		return row.err
+	}
+	if len(row.values) == 0 {
+		return pgx.ErrNoRows
+	}
+	return scanValues(row.values[0], dest)
+}
+
+type fakeRows struct {
+	values [][]interface{}
+	index  int
+	err    error
+}
+
+func (rows *fakeRows) Close()                                         {}
+func (rows *fakeRows) Err() error                                     { return rows.err }
+func (rows *fakeRows) CommandTag() pgconn.CommandTag                  { return nil }
+func (rows *fakeRows) FieldDescriptions() []pgproto3.FieldDescription { return nil }
+func (rows *fakeRows) RawValues() [][]byte                            { return nil }
+
+func (rows *fakeRows) Next() bool {
+	rows.index++
+	return rows.index < len(rows.values)
+}
+
+func (rows *fakeRows) Scan(dest ...interface{}) error {
+	return scanValues(rows.values[rows.index], dest)
+}
+
+func (rows *fakeRows) Values() ([]interface{}, error) {
+	return rows.values[rows.index], nil
+}
+
+func scanValues(values []interface{}, dest []interface{}) error {
+	if len(values) != len(dest) {
+		return fmt.Errorf("scanning %d values into %d destinations", len(values), len(dest))
+	}
+	for i, value := range values {
+		target := reflect.ValueOf(dest[i]).Elem()
+		if value == nil {
+			target.Set(reflect.Zero(target.Type()))
+			continue
+		}
+		source := reflect.ValueOf(value)
+		if target.Kind() == reflect.Pointer && source.Kind() != reflect.Pointer {
+			pointer := reflect.New(target.Type().Elem())
+			pointer.Elem().Set(source.Convert(target.Type().Elem()))
+			target.Set(pointer)
+			continue
+		}
+		target.Set(source.Convert(target.Type()))
+	}
+	return nil
+}
+
+func newTestRepository(pool *fakePool, options Options) repository {
+	return *New(pool, options).(*repository)
+}
@@ -0,0 +1,27 @@
+package productrepository
+
+import (
+	"fmt"
+	"strings"
+)
+
+type queryBuilder struct {
+	conditions []string
+	args       []interface{}
+}
+
+func (builder *queryBuilder) arg(value interface{}) string {
+	builder.args = append(builder.args, value)
+	return fmt.Sprintf("$%d", len(builder.args))
+}
+
+func (builder *queryBuilder) where(condition string) {
+	builder.conditions = append(builder.conditions, condition)
+}
+
+func (builder *queryBuilder) whereClause() string {
+	if len(builder.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(builder.conditions, " AND ")
+}
@@ -0,0 +1,45 @@
+package productrepository
+
+import (
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/jackc/pgx/v4"
+)
+
+const productColumns = "id, name, price, description, discount_type, discount_value, discount_starts_at, discount_ends_at, updated_at"
+
+// scanProduct scans a row selected with productColumns, followed by any extra
+// destinations for columns appended after them.
+func scanProduct(row pgx.Row, extra ...interface{}) (*domain.Product, error) {
+	product := domain.Product{}
+	var discountType *string
+	var discountValue *float32
+	var discountStartsAt, discountEndsAt *time.Time
+
+	err := row.Scan(append([]interface{}{
+		&product.ID,
+		&product.Name,
+		&product.Price,
+		&product.Description,
+		&discountType,
+		&discountValue,
+		&discountStartsAt,
+		&discountEndsAt,
+		&product.UpdatedAt,
+	}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if discountType != nil && discountValue != nil {
+		product.Discount = &domain.Discount{
+			Type:     *discountType,
+			Value:    *discountValue,
+			StartsAt: discountStartsAt,
+			EndsAt:   discountEndsAt,
+		}
+	}
+
+	return &product, nil
+}
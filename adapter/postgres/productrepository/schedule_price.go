@@ -0,0 +1,37 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+)
+
+func (repository repository) SchedulePrice(ctx context.Context, productID int32, schedulePriceRequest *dto.SchedulePriceRequest) (*domain.PriceSchedule, error) {
+	schedule := domain.PriceSchedule{}
+	err := repository.db.QueryRow(
+		ctx,
+		"INSERT INTO "+repository.companionTable("price_schedules")+" (product_id, new_price, effective_at) VALUES ($1, $2, $3) returning id, product_id, new_price, effective_at",
+		productID,
+		schedulePriceRequest.NewPrice,
+		schedulePriceRequest.EffectiveAt,
+	).Scan(
+		&schedule.ID,
+		&schedule.ProductID,
+		&schedule.NewPrice,
+		&schedule.EffectiveAt,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+		return nil, domain.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
@@ -0,0 +1,72 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestSchedulePrice(t *testing.T) {
+	effectiveAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{name: "scheduled"},
+		{name: "unknown product", err: &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation}, wantErr: domain.ErrProductNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+				if test.err != nil {
+					return nil, test.err
+				}
+				return [][]interface{}{{int32(3), int32(7), float32(9.5), effectiveAt}}, nil
+			}}
+
+			schedule, err := newTestRepository(pool, Options{Schema: "public"}).SchedulePrice(context.Background(), 7, &dto.SchedulePriceRequest{NewPrice: 9.5, EffectiveAt: effectiveAt})
+
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("SchedulePrice() error = %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr == nil && (schedule.ID != 3 || schedule.ProductID != 7 || !schedule.EffectiveAt.Equal(effectiveAt)) {
+				t.Errorf("SchedulePrice() = %+v", schedule)
+			}
+			if sql := pool.queries[0].sql; !strings.HasPrefix(sql, `INSERT INTO "public"."product_price_schedules" `) {
+				t.Errorf("sql = %q, want the qualified schedules table", sql)
+			}
+		})
+	}
+}
+
+func TestApplyDuePriceSchedules(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	pool := &fakePool{commandTag: pgconn.CommandTag("UPDATE 2")}
+
+	applied, err := newTestRepository(pool, Options{Schema: "public"}).ApplyDuePriceSchedules(context.Background(), now)
+	if err != nil {
+		t.Fatalf("ApplyDuePriceSchedules() error = %v", err)
+	}
+	if applied != 2 {
+		t.Errorf("applied = %d, want 2", applied)
+	}
+	query := pool.queries[0]
+	if !strings.Contains(query.sql, `DELETE FROM "public"."product_price_schedules" WHERE effective_at <= $1`) {
+		t.Errorf("sql = %q, want due schedules deleted from the qualified table", query.sql)
+	}
+	if !strings.Contains(query.sql, "DISTINCT ON (product_id)") {
+		t.Errorf("sql = %q, want only the latest due price per product", query.sql)
+	}
+	if len(query.args) != 1 || query.args[0] != now {
+		t.Errorf("args = %v, want [%v]", query.args, now)
+	}
+}
@@ -0,0 +1,87 @@
+package productrepository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) Search(ctx context.Context, searchRequest *dto.ProductSearchRequest) (*domain.Pagination[[]domain.Product], error) {
+	if err := repository.checkOffset(searchRequest.Page, searchRequest.ItemsPerPage); err != nil {
+		return nil, err
+	}
+	products := make([]domain.Product, 0, searchRequest.ItemsPerPage)
+
+	builder := &queryBuilder{}
+	if searchRequest.NameContains != "" {
+		builder.where(fmt.Sprintf("STRPOS(LOWER(name), LOWER(%s)) > 0", builder.arg(searchRequest.NameContains)))
+	}
+	if searchRequest.MinPrice != nil {
+		builder.where(fmt.Sprintf("price >= %s", builder.arg(*searchRequest.MinPrice)))
+	}
+	if searchRequest.MaxPrice != nil {
+		builder.where(fmt.Sprintf("price <= %s", builder.arg(*searchRequest.MaxPrice)))
+	}
+	if searchRequest.Filter != nil {
+		builder.where(builder.compileFilter(searchRequest.Filter))
+	}
+	where := builder.whereClause()
+	filterArgs := append([]interface{}{}, builder.args...)
+
+	query := "SELECT " + productColumns + " FROM " + repository.tableName + where +
+		searchOrderBy(searchRequest) +
+		fmt.Sprintf(" LIMIT %s OFFSET %s", builder.arg(searchRequest.ItemsPerPage), builder.arg((searchRequest.Page-1)*searchRequest.ItemsPerPage))
+	queryCount := "SELECT COUNT(id) FROM " + repository.tableName + where
+	logging.FromContext(ctx).Debug("Searching products", "sql", query, "countSql", queryCount)
+
+	{
+		rows, err := repository.db.Query(ctx, query, builder.args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			product, err := scanProduct(rows)
+			if err != nil {
+				return nil, err
+			}
+			products = append(products, *product)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	count, err := repository.count(ctx, queryCount, filterArgs, where != "")
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Pagination[[]domain.Product]{
+		Items:         products,
+		Total:         count.total,
+		CountStrategy: count.strategy,
+		TotalCapped:   count.capped,
+	}, nil
+}
+
+func searchOrderBy(searchRequest *dto.ProductSearchRequest) string {
+	orderBy := []string{}
+	hasID := false
+	for i, field := range searchRequest.Sort {
+		direction := "ASC"
+		if i < len(searchRequest.Descending) && searchRequest.Descending[i] {
+			direction = "DESC"
+		}
+		orderBy = append(orderBy, field+" "+direction)
+		hasID = hasID || field == "id"
+	}
+	if !hasID {
+		orderBy = append(orderBy, "id ASC")
+	}
+	return " ORDER BY " + strings.Join(orderBy, ", ")
+}
@@ -0,0 +1,47 @@
+package productrepository
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestSearchCombinesFilters(t *testing.T) {
+	pool := &fakePool{respond: func(sql string, args []interface{}) ([][]interface{}, error) {
+		if strings.HasPrefix(sql, "SELECT COUNT") {
+			return [][]interface{}{{int32(0)}}, nil
+		}
+		return nil, nil
+	}}
+	minPrice, maxPrice := float32(10), float32(100)
+	searchRequest := &dto.ProductSearchRequest{
+		NameContains: "oak",
+		MinPrice:     &minPrice,
+		MaxPrice:     &maxPrice,
+		Filter:       &dto.FilterNode{Field: "description", Op: "contains", Value: "chair"},
+		Sort:         []string{"price", "name"},
+		Descending:   []bool{true},
+		Page:         3,
+		ItemsPerPage: 20,
+	}
+
+	if _, err := newTestRepository(pool, Options{}).Search(context.Background(), searchRequest); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	where := ` WHERE STRPOS(LOWER(name), LOWER($1)) > 0 AND price >= $2 AND price <= $3 AND STRPOS(LOWER(description), LOWER($4)) > 0`
+	filterArgs := []interface{}{"oak", float32(10), float32(100), "chair"}
+	want := []recordedQuery{
+		{
+			sql:  `SELECT ` + productColumns + ` FROM "product"` + where + ` ORDER BY price DESC, name ASC, id ASC LIMIT $5 OFFSET $6`,
+			args: append(append([]interface{}{}, filterArgs...), 20, 40),
+		},
+		{sql: `SELECT COUNT(id) FROM "product"` + where, args: filterArgs},
+	}
+	if !reflect.DeepEqual(pool.queries, want) {
+		t.Errorf("queries = %+v, want %+v", pool.queries, want)
+	}
+}
@@ -0,0 +1,57 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/jackc/pgx/v4"
+)
+
+// UpdateIfUnmodified replaces the product with id only while its updated_at
+// is still updatedAt. The condition is part of the UPDATE, so a write that
+// lands between reading the product and replacing it fails with
+// domain.ErrPreconditionFailed instead of being overwritten.
+func (repository repository) UpdateIfUnmodified(ctx context.Context, id int32, updatedAt time.Time, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	var discountType *string
+	var discountValue *float32
+	var discountStartsAt, discountEndsAt *time.Time
+	if discount := productRequest.Discount; discount != nil {
+		discountType = &discount.Type
+		discountValue = &discount.Value
+		discountStartsAt = discount.StartsAt
+		discountEndsAt = discount.EndsAt
+	}
+
+	product, err := scanProduct(repository.db.QueryRow(
+		ctx,
+		`UPDATE `+repository.tableName+` SET
+			name = $3,
+			price = $4,
+			description = $5,
+			discount_type = $6,
+			discount_value = $7,
+			discount_starts_at = $8,
+			discount_ends_at = $9
+		WHERE id = $1 AND updated_at = $2
+		RETURNING `+productColumns,
+		id,
+		updatedAt,
+		productRequest.Name,
+		productRequest.Price,
+		productRequest.Description,
+		discountType,
+		discountValue,
+		discountStartsAt,
+		discountEndsAt,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPreconditionFailed
+	}
+	if err != nil {
+		return nil, err
+	}
+	return product, nil
+}
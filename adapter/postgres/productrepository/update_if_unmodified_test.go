@@ -0,0 +1,50 @@
+package productrepository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestUpdateIfUnmodified(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	request := &dto.CreateProductRequest{Name: "Chair", Price: 10, Description: "Oak chair"}
+	tests := []struct {
+		name    string
+		rows    [][]interface{}
+		wantErr error
+	}{
+		{name: "unmodified", rows: [][]interface{}{{int32(9), "Chair", float32(10), "Oak chair", nil, nil, nil, nil, updatedAt.Add(time.Second)}}},
+		{name: "modified or deleted", wantErr: domain.ErrPreconditionFailed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+				return test.rows, nil
+			}}
+
+			product, err := newTestRepository(pool, Options{}).UpdateIfUnmodified(context.Background(), 9, updatedAt, request)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("UpdateIfUnmodified() error = %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr == nil && product.ID != 9 {
+				t.Errorf("UpdateIfUnmodified() = %+v, want id 9", product)
+			}
+			if len(pool.queries) != 1 {
+				t.Fatalf("statements = %d, want 1", len(pool.queries))
+			}
+			query := pool.queries[0]
+			if !strings.Contains(query.sql, "WHERE id = $1 AND updated_at = $2") {
+				t.Errorf("sql = %q, want the update conditioned on id and updated_at", query.sql)
+			}
+			if query.args[0] != int32(9) || query.args[1] != updatedAt {
+				t.Errorf("args = %v, want id 9 and updated_at %v", query.args[:2], updatedAt)
+			}
+		})
+	}
+}
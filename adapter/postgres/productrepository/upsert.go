@@ -0,0 +1,70 @@
+package productrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/jackc/pgx/v4"
+)
+
+func (repository repository) Upsert(ctx context.Context, id int32, productRequest *dto.CreateProductRequest) (*domain.Product, bool, error) {
+	var discountType *string
+	var discountValue *float32
+	var discountStartsAt, discountEndsAt *time.Time
+	if discount := productRequest.Discount; discount != nil {
+		discountType = &discount.Type
+		discountValue = &discount.Value
+		discountStartsAt = discount.StartsAt
+		discountEndsAt = discount.EndsAt
+	}
+
+	var product *domain.Product
+	created := false
+	err := repository.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		product, err = scanProduct(tx.QueryRow(
+			ctx,
+			`INSERT INTO `+repository.tableName+` (id, name, price, description, discount_type, discount_value, discount_starts_at, discount_ends_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (id) DO UPDATE SET
+				name = EXCLUDED.name,
+				price = EXCLUDED.price,
+				description = EXCLUDED.description,
+				discount_type = EXCLUDED.discount_type,
+				discount_value = EXCLUDED.discount_value,
+				discount_starts_at = EXCLUDED.discount_starts_at,
+				discount_ends_at = EXCLUDED.discount_ends_at
+			RETURNING `+productColumns+`, xmax = 0`,
+			id,
+			productRequest.Name,
+			productRequest.Price,
+			productRequest.Description,
+			discountType,
+			discountValue,
+			discountStartsAt,
+			discountEndsAt,
+		), &created)
+		if err != nil || !created {
+			return err
+		}
+
+		// Ids chosen by the client bypass the serial sequence; move it past
+		// them so later creates do not collide.
+		_, err = tx.Exec(
+			ctx,
+			"SELECT setval(pg_get_serial_sequence($1, 'id'), (SELECT MAX(id) FROM "+repository.tableName+"))",
+			repository.tableName,
+		)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if created {
+		repository.invalidateTotals()
+	}
+
+	return product, created, nil
+}
@@ -0,0 +1,47 @@
+package productrepository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestUpsert(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	request := &dto.CreateProductRequest{Name: "Chair", Price: 10, Description: "Oak chair"}
+	tests := []struct {
+		name           string
+		inserted       bool
+		wantStatements int
+	}{
+		{name: "update", inserted: false, wantStatements: 1},
+		{name: "create", inserted: true, wantStatements: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := &fakePool{respond: func(string, []interface{}) ([][]interface{}, error) {
+				return [][]interface{}{{int32(9), "Chair", float32(10), "Oak chair", nil, nil, nil, nil, updatedAt, test.inserted}}, nil
+			}}
+
+			product, created, err := newTestRepository(pool, Options{}).Upsert(context.Background(), 9, request)
+			if err != nil {
+				t.Fatalf("Upsert() error = %v", err)
+			}
+			if created != test.inserted || product.ID != 9 {
+				t.Errorf("Upsert() = %+v, %v; want id 9 created %v", product, created, test.inserted)
+			}
+			if !strings.Contains(pool.queries[0].sql, "ON CONFLICT (id) DO UPDATE") {
+				t.Errorf("sql = %q, want an upsert on id", pool.queries[0].sql)
+			}
+			if len(pool.queries) != test.wantStatements {
+				t.Fatalf("statements = %d, want %d", len(pool.queries), test.wantStatements)
+			}
+			if test.inserted && !strings.Contains(pool.queries[1].sql, "setval(pg_get_serial_sequence") {
+				t.Errorf("sql = %q, want the id sequence moved past the new id", pool.queries[1].sql)
+			}
+		})
+	}
+}
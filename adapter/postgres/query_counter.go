@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type queryCounterKey struct{}
+
+// WithQueryCounter starts counting the queries run through a CountQueries
+// pool with the returned context.
+func WithQueryCounter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryCounterKey{}, &atomic.Int64{})
+}
+
+// QueryCount reports the queries counted so far for ctx.
+func QueryCount(ctx context.Context) int64 {
+	if counter, ok := ctx.Value(queryCounterKey{}).(*atomic.Int64); ok {
+		return counter.Load()
+	}
+	return 0
+}
+
+func countQuery(ctx context.Context) {
+	if counter, ok := ctx.Value(queryCounterKey{}).(*atomic.Int64); ok {
+		counter.Add(1)
+	}
+}
+
+type countingPool struct {
+	PoolInterface
+}
+
+// CountQueries wraps pool so every statement, including those run inside
+// its transactions, is counted against the context it runs with. Beginning
+// a transaction counts as one query; a batch counts as one round trip.
+func CountQueries(pool PoolInterface) PoolInterface {
+	return &countingPool{PoolInterface: pool}
+}
+
+func (pool *countingPool) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	countQuery(ctx)
+	return pool.PoolInterface.Exec(ctx, sql, arguments...)
+}
+
+func (pool *countingPool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	countQuery(ctx)
+	return pool.PoolInterface.Query(ctx, sql, args...)
+}
+
+func (pool *countingPool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	countQuery(ctx)
+	return pool.PoolInterface.QueryRow(ctx, sql, args...)
+}
+
+func (pool *countingPool) QueryFunc(ctx context.Context, sql string, args []interface{}, scans []interface{}, f func(pgx.QueryFuncRow) error) (pgconn.CommandTag, error) {
+	countQuery(ctx)
+	return pool.PoolInterface.QueryFunc(ctx, sql, args, scans, f)
+}
+
+func (pool *countingPool) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	countQuery(ctx)
+	return pool.PoolInterface.SendBatch(ctx, b)
+}
+
+func (pool *countingPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	countQuery(ctx)
+	tx, err := pool.PoolInterface.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &countingTx{Tx: tx}, nil
+}
+
+func (pool *countingPool) BeginFunc(ctx context.Context, f func(pgx.Tx) error) error {
+	countQuery(ctx)
+	return pool.PoolInterface.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return f(&countingTx{Tx: tx})
+	})
+}
+
+func (pool *countingPool) BeginTxFunc(ctx context.Context, txOptions pgx.TxOptions, f func(pgx.Tx) error) error {
+	countQuery(ctx)
+	return pool.PoolInterface.BeginTxFunc(ctx, txOptions, func(tx pgx.Tx) error {
+		return f(&countingTx{Tx: tx})
+	})
+}
+
+type countingTx struct {
+	pgx.Tx
+}
+
+func (tx *countingTx) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	countQuery(ctx)
+	return tx.Tx.Exec(ctx, sql, arguments...)
+}
+
+func (tx *countingTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	countQuery(ctx)
+	return tx.Tx.Query(ctx, sql, args...)
+}
+
+func (tx *countingTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	countQuery(ctx)
+	return tx.Tx.QueryRow(ctx, sql, args...)
+}
+
+func (tx *countingTx) QueryFunc(ctx context.Context, sql string, args []interface{}, scans []interface{}, f func(pgx.QueryFuncRow) error) (pgconn.CommandTag, error) {
+	countQuery(ctx)
+	return tx.Tx.QueryFunc(ctx, sql, args, scans, f)
+}
+
+func (tx *countingTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	countQuery(ctx)
+	return tx.Tx.SendBatch(ctx, b)
+}
+
+func (tx *countingTx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	countQuery(ctx)
+	return tx.Tx.CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+
+func (tx *countingTx) BeginFunc(ctx context.Context, f func(pgx.Tx) error) error {
+	countQuery(ctx)
+	return tx.Tx.BeginFunc(ctx, func(savepoint pgx.Tx) error {
+		return f(&countingTx{Tx: savepoint})
+	})
+}
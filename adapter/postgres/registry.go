@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/spf13/viper"
+)
+
+const PrimaryDatabase = "primary"
+
+// Registry holds the pools configured under "databases.<name>". Without a
+// "databases.primary" entry, the primary pool falls back to "database.url".
+type Registry struct {
+	pools map[string]*pgxpool.Pool
+}
+
+func NewRegistry(ctx context.Context) (*Registry, error) {
+	registry := &Registry{pools: map[string]*pgxpool.Pool{}}
+	for name := range viper.GetStringMap("databases") {
+		key := "databases." + name
+		pool := Connect(ctx, viper.GetString(key+".url"))
+		if pool == nil {
+			registry.Close()
+			return nil, fmt.Errorf("unable to connect to database %q", name)
+		}
+		registry.pools[name] = pool
+	}
+
+	if _, ok := registry.pools[PrimaryDatabase]; !ok {
+		pool := GetConnection(ctx)
+		if pool == nil {
+			registry.Close()
+			return nil, fmt.Errorf("unable to connect to database %q", PrimaryDatabase)
+		}
+		registry.pools[PrimaryDatabase] = pool
+	}
+
+	return registry, nil
+}
+
+// Get returns the named pool, falling back to the primary pool when name is
+// empty.
+func (registry *Registry) Get(name string) (*pgxpool.Pool, error) {
+	if name == "" {
+		name = PrimaryDatabase
+	}
+	pool, ok := registry.pools[name]
+	if !ok {
+		return nil, fmt.Errorf("database %q is not configured", name)
+	}
+	return pool, nil
+}
+
+func (registry *Registry) Primary() *pgxpool.Pool {
+	return registry.pools[PrimaryDatabase]
+}
+
+// Names returns the name of every pool, sorted.
+func (registry *Registry) Names() []string {
+	names := make([]string, 0, len(registry.pools))
+	for name := range registry.pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Ping pings every pool, so a registry is only reachable when all of its
+// databases are.
+func (registry *Registry) Ping(ctx context.Context) error {
+	for _, name := range registry.Names() {
+		if err := registry.pools[name].Ping(ctx); err != nil {
+			return fmt.Errorf("database %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Stats returns the statistics of every pool by name.
+func (registry *Registry) Stats() map[string]PoolStats {
+	stats := make(map[string]PoolStats, len(registry.pools))
+	for name, pool := range registry.pools {
+		stats[name] = GetPoolStats(pool)
+	}
+	return stats
+}
+
+// Warmup warms every pool, stopping at the first error.
+func (registry *Registry) Warmup(ctx context.Context) error {
+	for name, pool := range registry.pools {
+		if err := Warmup(ctx, pool); err != nil {
+			return fmt.Errorf("unable to warm up database %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (registry *Registry) Close() {
+	for _, pool := range registry.pools {
+		pool.Close()
+	}
+}
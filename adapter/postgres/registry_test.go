@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// configure replaces the viper configuration for the rest of the test.
+func configure(t *testing.T, values map[string]any) {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	for key, value := range values {
+		viper.Set(key, value)
+	}
+}
+
+func TestDatabaseURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]any
+		database string
+		want     string
+	}{
+		{name: "single database", config: map[string]any{"database.url": "://single"}, database: "", want: "://single"},
+		{name: "named primary", config: map[string]any{"database.url": "://single", "databases.primary.url": "://primary"}, database: PrimaryDatabase, want: "://primary"},
+		{name: "named secondary", config: map[string]any{"database.url": "://single", "databases.analytics.url": "://analytics"}, database: "analytics", want: "://analytics"},
+		{name: "unconfigured secondary", config: map[string]any{"database.url": "://single"}, database: "analytics", want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configure(t, test.config)
+
+			if got := DatabaseURL(test.database); got != test.want {
+				t.Errorf("DatabaseURL(%q) = %q, want %q", test.database, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewRegistryIntegration(t *testing.T) {
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	url := strings.TrimPrefix(databaseURL, "postgres")
+	configure(t, map[string]any{
+		"databases.primary.url":   url,
+		"databases.analytics.url": url + "?application_name=analytics",
+	})
+	ctx := context.Background()
+
+	registry, err := NewRegistry(ctx)
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	defer registry.Close()
+
+	if names := registry.Names(); !reflect.DeepEqual(names, []string{"analytics", PrimaryDatabase}) {
+		t.Errorf("Names() = %v, want [analytics primary]", names)
+	}
+	for _, name := range []string{"analytics", PrimaryDatabase} {
+		pool, err := registry.Get(name)
+		if err != nil {
+			t.Fatalf("Get(%q) error = %v", name, err)
+		}
+		var applicationName string
+		if err := pool.QueryRow(ctx, "SHOW application_name").Scan(&applicationName); err != nil {
+			t.Fatal(err)
+		}
+		if (applicationName == "analytics") != (name == "analytics") {
+			t.Errorf("Get(%q) connected as application %q, want its own configuration", name, applicationName)
+		}
+	}
+	if pool, err := registry.Get(""); err != nil || pool != registry.Primary() {
+		t.Errorf("Get(\"\") = %v, %v, want the primary pool", pool, err)
+	}
+	if _, err := registry.Get("reporting"); err == nil {
+		t.Error("Get() of an unconfigured database error = nil")
+	}
+}
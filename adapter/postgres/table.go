@@ -0,0 +1,34 @@
+package postgres
+
+import "github.com/jackc/pgx/v4"
+
+const (
+	DefaultTable  = "product"
+	DefaultSchema = "public"
+)
+
+// TableIdentifier qualifies table with schema when one is configured.
+func TableIdentifier(schema string, table string) pgx.Identifier {
+	if table == "" {
+		table = DefaultTable
+	}
+	if schema == "" {
+		return pgx.Identifier{table}
+	}
+	return pgx.Identifier{schema, table}
+}
+
+// CompanionTable names a table the migrations create next to the product
+// table, such as product_tombstones for the "tombstones" suffix, in the
+// product table's schema.
+func CompanionTable(table pgx.Identifier, suffix string) pgx.Identifier {
+	return inSchema(table, table[len(table)-1]+"_"+suffix)
+}
+
+// inSchema names an object in the product table's schema.
+func inSchema(table pgx.Identifier, name string) pgx.Identifier {
+	if len(table) > 1 {
+		return pgx.Identifier{table[0], name}
+	}
+	return pgx.Identifier{name}
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import "testing"
+
+func TestTableNames(t *testing.T) {
+	tests := []struct {
+		schema, table        string
+		wantTable, companion string
+	}{
+		{wantTable: `"product"`, companion: `"product_tombstones"`},
+		{schema: "public", table: "product", wantTable: `"public"."product"`, companion: `"public"."product_tombstones"`},
+		{schema: "catalog", wantTable: `"catalog"."product"`, companion: `"catalog"."product_tombstones"`},
+		{schema: "catalog", table: "items", wantTable: `"catalog"."items"`, companion: `"catalog"."items_tombstones"`},
+		{table: `items"; DROP TABLE product; --`, wantTable: `"items""; DROP TABLE product; --"`, companion: `"items""; DROP TABLE product; --_tombstones"`},
+	}
+	for _, test := range tests {
+		t.Run(test.schema+"."+test.table, func(t *testing.T) {
+			table := TableIdentifier(test.schema, test.table)
+			if got := table.Sanitize(); got != test.wantTable {
+				t.Errorf("table = %s, want %s", got, test.wantTable)
+			}
+			if got := CompanionTable(table, "tombstones").Sanitize(); got != test.companion {
+				t.Errorf("companion = %s, want %s", got, test.companion)
+			}
+		})
+	}
+}
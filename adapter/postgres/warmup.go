@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// Warmup opens the pool's MinConns connections up front by acquiring them
+// all at once and releasing them, so the first requests after startup do
+// not pay for connection setup. pgxpool keeps them open afterwards.
+func Warmup(ctx context.Context, pool *pgxpool.Pool) error {
+	minConns := int(pool.Config().MinConns)
+	conns := make([]*pgxpool.Conn, 0, minConns)
+	defer func() {
+		for _, conn := range conns {
+			conn.Release()
+		}
+	}()
+
+	for len(conns) < minConns {
+		conn, err := pool.Acquire(ctx)
+		if err != nil {
+			return err
+		}
+		conns = append(conns, conn)
+	}
+	return nil
+}
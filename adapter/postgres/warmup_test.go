@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+func TestWarmupIntegration(t *testing.T) {
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	config, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.MinConns = 3
+	// Connect lazily so the connections are opened by Warmup rather than by
+	// ConnectConfig.
+	config.LazyConnect = true
+	pool, err := pgxpool.ConnectConfig(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	if total := pool.Stat().TotalConns(); total >= config.MinConns {
+		t.Fatalf("connections before Warmup = %d, want fewer than %d", total, config.MinConns)
+	}
+
+	if err := Warmup(context.Background(), pool); err != nil {
+		t.Fatalf("Warmup() error = %v", err)
+	}
+	if total := pool.Stat().TotalConns(); total < config.MinConns {
+		t.Errorf("connections after Warmup = %d, want at least %d", total, config.MinConns)
+	}
+}
@@ -0,0 +1,15 @@
+package productretry
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) Count(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	return retry.Do(ctx, repository.policy, func() (*domain.Pagination[[]domain.Product], error) {
+		return repository.ProductRepository.Count(ctx, pagination)
+	})
+}
@@ -0,0 +1,14 @@
+package productretry
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) FetchIDs(ctx context.Context, pagination *dto.PaginationRequestParams) ([]int32, error) {
+	return retry.Do(ctx, repository.policy, func() ([]int32, error) {
+		return repository.ProductRepository.FetchIDs(ctx, pagination)
+	})
+}
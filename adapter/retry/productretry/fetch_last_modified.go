@@ -0,0 +1,15 @@
+package productretry
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) FetchLastModified(ctx context.Context, pagination *dto.PaginationRequestParams) (*time.Time, error) {
+	return retry.Do(ctx, repository.policy, func() (*time.Time, error) {
+		return repository.ProductRepository.FetchLastModified(ctx, pagination)
+	})
+}
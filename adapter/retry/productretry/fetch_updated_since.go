@@ -0,0 +1,16 @@
+package productretry
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (repository repository) FetchUpdatedSince(ctx context.Context, since time.Time, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	return retry.Do(ctx, repository.policy, func() (*domain.Pagination[[]domain.Product], error) {
+		return repository.ProductRepository.FetchUpdatedSince(ctx, since, pagination)
+	})
+}
@@ -0,0 +1,90 @@
+package productretry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// flakyRepository fails its calls with errs in order, then succeeds.
+type flakyRepository struct {
+	domain.ProductRepository
+	errs  []error
+	calls int
+}
+
+func (repository *flakyRepository) next() error {
+	repository.calls++
+	if repository.calls > len(repository.errs) {
+		return nil
+	}
+	return repository.errs[repository.calls-1]
+}
+
+func (repository *flakyRepository) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	if err := repository.next(); err != nil {
+		return nil, err
+	}
+	return &domain.Product{ID: id}, nil
+}
+
+func (repository *flakyRepository) Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	if err := repository.next(); err != nil {
+		return nil, err
+	}
+	return &domain.Product{ID: 1}, nil
+}
+
+var policy = retry.Policy{MaxAttempts: 3, BaseDelay: time.Microsecond, Retryable: postgres.IsTransient}
+
+func TestGetByIDRetriesTransientErrors(t *testing.T) {
+	transient := &pgconn.PgError{Code: pgerrcode.SerializationFailure}
+	tests := []struct {
+		name      string
+		errs      []error
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "transient", errs: []error{transient}, wantCalls: 2},
+		{name: "non-transient", errs: []error{domain.ErrProductNotFound}, wantErr: domain.ErrProductNotFound, wantCalls: 1},
+		{name: "transient every time", errs: []error{transient, transient, transient}, wantErr: transient, wantCalls: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flaky := &flakyRepository{errs: test.errs}
+
+			product, err := New(flaky, policy).GetByID(context.Background(), 7)
+
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("GetByID() error = %v, want %v", err, test.wantErr)
+			}
+			if flaky.calls != test.wantCalls {
+				t.Errorf("calls = %d, want %d", flaky.calls, test.wantCalls)
+			}
+			if test.wantErr == nil && product.ID != 7 {
+				t.Errorf("GetByID() = %+v, want product 7", product)
+			}
+		})
+	}
+}
+
+func TestCreateIsNotRetried(t *testing.T) {
+	transient := &pgconn.PgError{Code: pgerrcode.SerializationFailure}
+	flaky := &flakyRepository{errs: []error{transient}}
+
+	if _, err := New(flaky, policy).Create(context.Background(), &dto.CreateProductRequest{}); !errors.Is(err, transient) {
+		t.Fatalf("Create() error = %v, want %v", err, transient)
+	}
+	if flaky.calls != 1 {
+		t.Errorf("calls = %d, want 1", flaky.calls)
+	}
+}
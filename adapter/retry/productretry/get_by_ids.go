@@ -0,0 +1,14 @@
+package productretry
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (repository repository) GetByIDs(ctx context.Context, ids []int32) ([]domain.Product, error) {
+	return retry.Do(ctx, repository.policy, func() ([]domain.Product, error) {
+		return repository.ProductRepository.GetByIDs(ctx, ids)
+	})
+}
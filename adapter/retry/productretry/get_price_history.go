@@ -0,0 +1,14 @@
+package productretry
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (repository repository) GetPriceHistory(ctx context.Context, productID int32) ([]domain.PriceChange, error) {
+	return retry.Do(ctx, repository.policy, func() ([]domain.PriceChange, error) {
+		return repository.ProductRepository.GetPriceHistory(ctx, productID)
+	})
+}
@@ -0,0 +1,18 @@
+package productretry
+
+import (
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+type repository struct {
+	domain.ProductRepository
+	policy retry.Policy
+}
+
+func New(productRepository domain.ProductRepository, policy retry.Policy) domain.ProductRepository {
+	return &repository{
+		ProductRepository: productRepository,
+		policy:            policy,
+	}
+}
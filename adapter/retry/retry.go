@@ -0,0 +1,53 @@
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+type Policy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Retryable   func(error) bool
+}
+
+// Do calls fn until it succeeds, returns a non-retryable error or runs out of
+// attempts. Waiting between attempts stops early when ctx is done.
+func Do[T any](ctx context.Context, policy Policy, fn func() (T, error)) (T, error) {
+	attempts := policy.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var result T
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(policy.backoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return result, err
+			case <-timer.C:
+			}
+		}
+		result, err = fn()
+		if err == nil || policy.Retryable == nil || !policy.Retryable(err) {
+			return result, err
+		}
+	}
+	return result, err
+}
+
+func (policy Policy) backoff(attempt int) time.Duration {
+	if policy.BaseDelay <= 0 {
+		return 0
+	}
+	delay := policy.BaseDelay << (attempt - 1)
+	if policy.MaxDelay > 0 && (delay > policy.MaxDelay || delay <= 0) {
+		delay = policy.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
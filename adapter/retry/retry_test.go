@@ -0,0 +1,88 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	errTransient = errors.New("connection reset")
+	errPermanent = errors.New("syntax error")
+)
+
+func TestDo(t *testing.T) {
+	policy := Policy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Microsecond,
+		Retryable:   func(err error) bool { return errors.Is(err, errTransient) },
+	}
+	tests := []struct {
+		name         string
+		errs         []error
+		wantErr      error
+		wantAttempts int
+	}{
+		{name: "first attempt", errs: []error{nil}, wantAttempts: 1},
+		{name: "transient then success", errs: []error{errTransient, errTransient, nil}, wantAttempts: 3},
+		{name: "non-transient", errs: []error{errPermanent, nil}, wantErr: errPermanent, wantAttempts: 1},
+		{name: "attempts exhausted", errs: []error{errTransient, errTransient, errTransient, nil}, wantErr: errTransient, wantAttempts: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := 0
+			result, err := Do(context.Background(), policy, func() (int, error) {
+				err := test.errs[attempts]
+				attempts++
+				if err != nil {
+					return 0, err
+				}
+				return 42, nil
+			})
+
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("Do() error = %v, want %v", err, test.wantErr)
+			}
+			if attempts != test.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, test.wantAttempts)
+			}
+			if test.wantErr == nil && result != 42 {
+				t.Errorf("Do() = %d, want 42", result)
+			}
+		})
+	}
+}
+
+func TestDoStopsWaitingWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := Policy{MaxAttempts: 3, BaseDelay: time.Hour, Retryable: func(error) bool { return true }}
+
+	attempts := 0
+	_, err := Do(ctx, policy, func() (int, error) {
+		attempts++
+		cancel()
+		return 0, errTransient
+	})
+
+	if !errors.Is(err, errTransient) || attempts != 1 {
+		t.Errorf("Do() = %v after %d attempts, want %v after 1", err, attempts, errTransient)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := Policy{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	for attempt, ceiling := range map[int]time.Duration{
+		1:  10 * time.Millisecond,
+		2:  20 * time.Millisecond,
+		3:  40 * time.Millisecond,
+		4:  50 * time.Millisecond,
+		70: 50 * time.Millisecond,
+	} {
+		for i := 0; i < 100; i++ {
+			if delay := policy.backoff(attempt); delay < 0 || delay > ceiling {
+				t.Fatalf("backoff(%d) = %v, want between 0 and %v", attempt, delay, ceiling)
+			}
+		}
+	}
+}
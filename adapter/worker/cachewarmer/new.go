@@ -0,0 +1,21 @@
+package cachewarmer
+
+import (
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+const defaultRetryDelay = 5 * time.Second
+
+type Worker struct {
+	usecase    domain.ProductUseCase
+	retryDelay time.Duration
+}
+
+func New(usecase domain.ProductUseCase) *Worker {
+	return &Worker{
+		usecase:    usecase,
+		retryDelay: defaultRetryDelay,
+	}
+}
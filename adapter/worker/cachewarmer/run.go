@@ -0,0 +1,30 @@
+package cachewarmer
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// Run fetches the default product listing once so the cache behind the use
+// case holds it, retrying until it succeeds, then idles until shutdown.
+func (worker *Worker) Run(ctx context.Context) {
+	for {
+		products, err := worker.usecase.Fetch(ctx, dto.DefaultPaginationRequestParams())
+		if err == nil {
+			slog.Info("Warmed product cache", "items", len(products.Items))
+			break
+		}
+		slog.Warn("Unable to warm product cache", "error", err, "retryIn", worker.retryDelay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(worker.retryDelay):
+		}
+	}
+
+	<-ctx.Done()
+}
@@ -0,0 +1,78 @@
+package cachewarmer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/cache"
+	"github.com/gabriwl165/clean-arch-go/adapter/cache/productcache"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+// recordingStore reports every value set in the memory store it wraps.
+type recordingStore struct {
+	cache.Store
+	set chan any
+}
+
+func (store recordingStore) Set(key string, value any, ttl time.Duration) {
+	store.Store.Set(key, value, ttl)
+	store.set <- value
+}
+
+// listingRepository fails the first fetch, then serves a single product; any
+// other call panics on the nil embedded interface.
+type listingRepository struct {
+	domain.ProductRepository
+	fetches    int
+	pagination *dto.PaginationRequestParams
+}
+
+func (repository *listingRepository) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	repository.fetches++
+	if repository.fetches == 1 {
+		return nil, errors.New("connection refused")
+	}
+	repository.pagination = pagination
+	return &domain.Pagination[[]domain.Product]{Items: []domain.Product{{ID: 1, Name: "Lamp"}}, Total: 1}, nil
+}
+
+func TestRunWarmsTheCache(t *testing.T) {
+	repository := &listingRepository{}
+	store := recordingStore{Store: cache.NewMemory(10), set: make(chan any, 1)}
+	worker := New(productusecase.New(productcache.New(repository, store), productusecase.Options{}))
+	worker.retryDelay = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case value := <-store.set:
+		products, ok := value.(*domain.Pagination[[]domain.Product])
+		if !ok || len(products.Items) != 1 || products.Items[0].ID != 1 {
+			t.Errorf("cached %+v, want the first page", value)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("cache not populated")
+	}
+	if repository.fetches != 2 {
+		t.Errorf("fetches = %d, want a retry after the failure", repository.fetches)
+	}
+	if want := dto.DefaultPaginationRequestParams(); repository.pagination.Page != want.Page || repository.pagination.ItemsPerPage != want.ItemsPerPage {
+		t.Errorf("warmed %+v, want the default listing", repository.pagination)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run() did not return after cancel")
+	}
+}
@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const defaultRestartDelay = time.Second
+
+// Worker is a background task that runs until ctx is cancelled.
+type Worker interface {
+	Run(ctx context.Context)
+}
+
+// Func adapts a plain function to the Worker interface.
+type Func func(ctx context.Context)
+
+func (f Func) Run(ctx context.Context) {
+	f(ctx)
+}
+
+type namedWorker struct {
+	name   string
+	worker Worker
+}
+
+// Manager owns the lifecycle of registered workers. Each worker runs in its
+// own goroutine and is restarted if it panics or returns before shutdown.
+type Manager struct {
+	workers      []namedWorker
+	restartDelay time.Duration
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		restartDelay: defaultRestartDelay,
+	}
+}
+
+// Register adds a worker. Workers registered after Start are not run.
+func (manager *Manager) Register(name string, worker Worker) {
+	manager.workers = append(manager.workers, namedWorker{name: name, worker: worker})
+}
+
+func (manager *Manager) Start(ctx context.Context) {
+	ctx, manager.cancel = context.WithCancel(ctx)
+	for _, worker := range manager.workers {
+		manager.wg.Add(1)
+		go manager.supervise(ctx, worker)
+	}
+}
+
+// Stop cancels every worker and waits for them to return.
+func (manager *Manager) Stop() {
+	if manager.cancel == nil {
+		return
+	}
+	manager.cancel()
+	manager.wg.Wait()
+}
+
+func (manager *Manager) supervise(ctx context.Context, worker namedWorker) {
+	defer manager.wg.Done()
+
+	for {
+		slog.Info("Starting worker", "worker", worker.name)
+		manager.run(ctx, worker)
+		if ctx.Err() != nil {
+			slog.Info("Stopped worker", "worker", worker.name)
+			return
+		}
+
+		slog.Warn("Worker exited before shutdown, restarting", "worker", worker.name, "delay", manager.restartDelay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(manager.restartDelay):
+		}
+	}
+}
+
+func (manager *Manager) run(ctx context.Context, worker namedWorker) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			slog.Error("Worker panicked", "worker", worker.name, "panic", recovered)
+		}
+	}()
+	worker.worker.Run(ctx)
+}
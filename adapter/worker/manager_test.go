@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestManagerRunsWorkersUntilStopped(t *testing.T) {
+	manager := NewManager()
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	manager.Register("blocking", Func(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		close(stopped)
+	}))
+
+	manager.Start(context.Background())
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("worker not started")
+	}
+	select {
+	case <-stopped:
+		t.Fatal("worker returned before Stop")
+	default:
+	}
+
+	manager.Stop()
+	select {
+	case <-stopped:
+	default:
+		t.Fatal("Stop() returned before the worker")
+	}
+}
+
+func TestManagerRestartsPanickingWorker(t *testing.T) {
+	manager := NewManager()
+	manager.restartDelay = time.Millisecond
+	runs := make(chan int, 3)
+	attempt := 0
+	manager.Register("flaky", Func(func(ctx context.Context) {
+		attempt++
+		runs <- attempt
+		if attempt < 3 {
+			panic("connection lost")
+		}
+		<-ctx.Done()
+	}))
+
+	manager.Start(context.Background())
+	defer manager.Stop()
+
+	for want := 1; want <= 3; want++ {
+		select {
+		case got := <-runs:
+			if got != want {
+				t.Fatalf("run %d, want %d", got, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("worker not restarted for run %d", want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package pricescheduler
+
+import (
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+type Worker struct {
+	usecase  domain.ProductUseCase
+	interval time.Duration
+}
+
+func New(usecase domain.ProductUseCase, interval time.Duration) *Worker {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &Worker{
+		usecase:  usecase,
+		interval: interval,
+	}
+}
@@ -0,0 +1,33 @@
+package pricescheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+func (worker *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(worker.interval)
+	defer ticker.Stop()
+
+	worker.apply(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			worker.apply(ctx)
+		}
+	}
+}
+
+func (worker *Worker) apply(ctx context.Context) {
+	applied, err := worker.usecase.ApplyDuePriceSchedules(ctx, time.Now())
+	if err != nil {
+		slog.Error("Unable to apply price schedules", "error", err)
+		return
+	}
+	if applied > 0 {
+		slog.Info("Applied scheduled price changes", "count", applied)
+	}
+}
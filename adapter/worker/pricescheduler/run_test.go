@@ -0,0 +1,52 @@
+package pricescheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+// applyUseCase reports each ApplyDuePriceSchedules call; any other call
+// panics on the nil embedded interface.
+type applyUseCase struct {
+	domain.ProductUseCase
+	applied chan time.Time
+}
+
+func (usecase applyUseCase) ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int64, error) {
+	usecase.applied <- now
+	return 1, nil
+}
+
+func TestRunAppliesDueSchedules(t *testing.T) {
+	usecase := applyUseCase{applied: make(chan time.Time)}
+	worker := New(usecase, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	// The first pass runs at start-up, the second on the ticker.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-usecase.applied:
+		case <-time.After(time.Second):
+			t.Fatalf("ApplyDuePriceSchedules() not called on pass %d", i+1)
+		}
+	}
+
+	cancel()
+	for {
+		select {
+		case <-usecase.applied:
+		case <-done:
+			return
+		case <-time.After(time.Second):
+			t.Fatal("Run() did not return after cancel")
+		}
+	}
+}
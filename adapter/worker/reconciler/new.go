@@ -0,0 +1,30 @@
+package reconciler
+
+import (
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/jackc/pgx/v4"
+)
+
+type Worker struct {
+	db           postgres.PoolInterface
+	table        pgx.Identifier
+	interval     time.Duration
+	overdueAfter time.Duration
+}
+
+// New returns a worker that runs the integrity checks every interval.
+// Price schedules still pending overdueAfter their effective time are
+// reported as overdue.
+func New(db postgres.PoolInterface, table pgx.Identifier, interval time.Duration, overdueAfter time.Duration) *Worker {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &Worker{
+		db:           db,
+		table:        table,
+		interval:     interval,
+		overdueAfter: overdueAfter,
+	}
+}
@@ -0,0 +1,40 @@
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/adapter/metrics"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+)
+
+func (worker *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(worker.interval)
+	defer ticker.Stop()
+
+	worker.check(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			worker.check(ctx)
+		}
+	}
+}
+
+func (worker *Worker) check(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	checks, err := postgres.CheckIntegrity(ctx, worker.db, worker.table, time.Now().Add(-worker.overdueAfter))
+	if err != nil {
+		logger.Error("Unable to run integrity checks", "error", err)
+		return
+	}
+	for _, check := range checks {
+		metrics.RecordIntegrityCheck(check.Name, check.Count)
+		if check.Count > 0 {
+			logger.Warn("Integrity check found anomalies", "check", check.Name, "count", check.Count)
+		}
+	}
+}
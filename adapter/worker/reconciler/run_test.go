@@ -0,0 +1,108 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/logging"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+)
+
+// countingPool answers each integrity query with the count of the first
+// entry in counts whose key the query contains, or zero.
+type countingPool struct {
+	postgres.PoolInterface
+	counts map[string]int64
+}
+
+func (pool countingPool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	for fragment, count := range pool.counts {
+		if strings.Contains(sql, fragment) {
+			return countRow(count)
+		}
+	}
+	return countRow(0)
+}
+
+type countRow int64
+
+func (row countRow) Scan(dest ...interface{}) error {
+	*dest[0].(*int64) = int64(row)
+	return nil
+}
+
+// capture returns a context whose logger writes into the returned buffer.
+func capture() (context.Context, *bytes.Buffer) {
+	output := &bytes.Buffer{}
+	return logging.WithLogger(context.Background(), slog.New(slog.NewTextHandler(output, nil))), output
+}
+
+func TestCheckReportsAnomalies(t *testing.T) {
+	pool := countingPool{counts: map[string]int64{"price_history": 2}}
+	worker := New(pool, pgx.Identifier{"product"}, time.Minute, time.Minute)
+	ctx, output := capture()
+
+	worker.check(ctx)
+
+	if want := "check=price_history_mismatch count=2"; !strings.Contains(output.String(), want) {
+		t.Errorf("log = %q, want it to contain %q", output.String(), want)
+	}
+	for _, clean := range []string{"non_positive_price", "overdue_price_schedule"} {
+		if strings.Contains(output.String(), clean) {
+			t.Errorf("log = %q, want no report for %s", output.String(), clean)
+		}
+	}
+}
+
+func TestCheckReportsSeededOrphanIntegration(t *testing.T) {
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+	table := postgres.TableIdentifier(schema, postgres.DefaultTable)
+	migrationsDir := filepath.Join("..", "..", "..", postgres.MigrationsDir)
+	if err := postgres.RunMigrations(ctx, strings.TrimPrefix(databaseURL, "postgres"), migrationsDir, table); err != nil {
+		t.Fatalf("RunMigrations() error = %v", err)
+	}
+	pool, err := pgxpool.Connect(ctx, databaseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := pool.Exec(context.Background(), "DROP SCHEMA "+pgx.Identifier{schema}.Sanitize()+" CASCADE"); err != nil {
+			t.Errorf("dropping %s: %v", schema, err)
+		}
+		pool.Close()
+	})
+
+	// Two products, and the price history of the second deleted behind the
+	// triggers' back so it points at no recorded price.
+	if _, err := pool.Exec(ctx, "INSERT INTO "+table.Sanitize()+" (name, price, description) VALUES ('Chair', 10, 'Oak chair'), ('Desk', 90, 'Oak desk')"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Exec(ctx, "DELETE FROM "+postgres.CompanionTable(table, "price_history").Sanitize()+" WHERE product_id = (SELECT MAX(id) FROM "+table.Sanitize()+")"); err != nil {
+		t.Fatal(err)
+	}
+
+	loggerCtx, output := capture()
+	New(pool, table, time.Minute, time.Minute).check(loggerCtx)
+
+	if want := "check=price_history_mismatch count=1"; !strings.Contains(output.String(), want) {
+		t.Errorf("log = %q, want it to contain %q", output.String(), want)
+	}
+	if strings.Contains(output.String(), "level=ERROR") {
+		t.Errorf("log = %q, want the checks to run", output.String())
+	}
+}
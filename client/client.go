@@ -0,0 +1,85 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type Options struct {
+	// Token is sent as a Bearer token when set.
+	Token      string
+	HTTPClient *http.Client
+}
+
+// Client is a typed client for the product API.
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+func New(baseURL string, options Options) *Client {
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      options.Token,
+		httpClient: httpClient,
+	}
+}
+
+// do sends a request and decodes a successful JSON response into out when
+// out is not nil. Non-2xx responses are converted by errorFromResponse.
+func (client *Client) do(ctx context.Context, method string, path string, query url.Values, body any, out any) error {
+	_, err := client.doStatus(ctx, method, path, query, body, out)
+	return err
+}
+
+// doStatus is do that also returns the status code of a successful response.
+func (client *Client) doStatus(ctx context.Context, method string, path string, query url.Values, body any, out any) (int, error) {
+	target := client.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return 0, err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if client.token != "" {
+		request.Header.Set("Authorization", "Bearer "+client.token)
+	}
+
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return 0, errorFromResponse(response)
+	}
+	if out == nil {
+		return response.StatusCode, nil
+	}
+	return response.StatusCode, json.NewDecoder(response.Body).Decode(out)
+}
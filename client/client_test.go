@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/http/middleware"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
+	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice/testutil"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+var updatedAt = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// memoryRepository keeps products in memory for the calls the client tests
+// make; any other call panics on the nil embedded interface.
+type memoryRepository struct {
+	domain.ProductRepository
+	products   []domain.Product
+	referenced map[int32]bool
+	pagination *dto.PaginationRequestParams
+}
+
+func (repository *memoryRepository) Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	product := domain.Product{
+		ID:          int32(len(repository.products) + 1),
+		Name:        productRequest.Name,
+		Price:       productRequest.Price,
+		Description: productRequest.Description,
+		UpdatedAt:   updatedAt,
+	}
+	if discount := productRequest.Discount; discount != nil {
+		product.Discount = &domain.Discount{Type: discount.Type, Value: discount.Value, StartsAt: discount.StartsAt, EndsAt: discount.EndsAt}
+	}
+	repository.products = append(repository.products, product)
+	return &product, nil
+}
+
+func (repository *memoryRepository) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	for _, product := range repository.products {
+		if product.ID == id {
+			return &product, nil
+		}
+	}
+	return nil, domain.ErrProductNotFound
+}
+
+func (repository *memoryRepository) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	repository.pagination = pagination
+	items := []domain.Product{}
+	if start := (pagination.Page - 1) * pagination.ItemsPerPage; start < len(repository.products) {
+		items = repository.products[start:min(start+pagination.ItemsPerPage, len(repository.products))]
+	}
+	return &domain.Pagination[[]domain.Product]{Items: slices.Clone(items), Total: int32(len(repository.products)), CountStrategy: domain.CountStrategyExact}, nil
+}
+
+func (repository *memoryRepository) FetchLastModified(ctx context.Context, pagination *dto.PaginationRequestParams) (*time.Time, error) {
+	return nil, nil
+}
+
+func (repository *memoryRepository) Delete(ctx context.Context, id int32) error {
+	if repository.referenced[id] {
+		return domain.ErrProductReferenced
+	}
+	if _, err := repository.GetByID(ctx, id); err != nil {
+		return err
+	}
+	repository.products = slices.DeleteFunc(repository.products, func(product domain.Product) bool { return product.ID == id })
+	return nil
+}
+
+// newTestClient serves the real product handlers, over a real use case, on
+// an httptest server and returns a client for it.
+func newTestClient(t *testing.T, repository *memoryRepository) (*Client, *httptest.Server) {
+	harness := testutil.New(productusecase.New(repository, productusecase.Options{}), productservice.Options{})
+	harness.Router.NotFoundHandler = middleware.NotFound()
+	server := httptest.NewServer(harness.Router)
+	t.Cleanup(server.Close)
+	return New(server.URL, Options{}), server
+}
+
+func TestClientRoundTrip(t *testing.T) {
+	repository := &memoryRepository{}
+	client, _ := newTestClient(t, repository)
+	ctx := context.Background()
+	startsAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	created, err := client.Create(ctx, &dto.CreateProductRequest{
+		Name:        "Lamp",
+		Price:       12.5,
+		Description: "Desk lamp",
+		Discount:    &dto.DiscountRequest{Type: domain.DiscountTypePercentage, Value: 10, StartsAt: &startsAt},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !reflect.DeepEqual(*created, repository.products[0]) {
+		t.Errorf("Create() = %+v, want the stored product %+v", *created, repository.products[0])
+	}
+
+	got, err := client.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, created) {
+		t.Errorf("GetByID() = %+v, want %+v", got, created)
+	}
+
+	if _, err := client.Create(ctx, &dto.CreateProductRequest{Name: "Chair", Price: 30, Description: "Oak chair"}); err != nil {
+		t.Fatal(err)
+	}
+	pagination := &dto.PaginationRequestParams{Name: "Chair", Sort: []string{"price"}, Descending: []string{"true"}, Page: 2, ItemsPerPage: 1}
+	page, err := client.Fetch(ctx, pagination)
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if want := (dto.PaginationRequestParams{Name: "Chair", Sort: []string{"price"}, Descending: []string{"true"}, Page: 2, ItemsPerPage: 1}); !reflect.DeepEqual(*repository.pagination, want) {
+		t.Errorf("pagination sent = %+v, want %+v", *repository.pagination, want)
+	}
+	if len(page.Items) != 1 || !reflect.DeepEqual(page.Items[0], repository.products[1]) || page.Total != 2 || page.CountStrategy != domain.CountStrategyExact {
+		t.Errorf("Fetch() = %+v, want the second product of 2", page)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	repository := &memoryRepository{
+		products:   []domain.Product{{ID: 1, Name: "Lamp", Price: 10, UpdatedAt: updatedAt}},
+		referenced: map[int32]bool{1: true},
+	}
+	client, server := newTestClient(t, repository)
+	ctx := context.Background()
+
+	if _, err := client.GetByID(ctx, 2); !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("GetByID(missing) error = %v, want %v", err, domain.ErrProductNotFound)
+	}
+	if err := client.Delete(ctx, 1); !errors.Is(err, domain.ErrProductReferenced) {
+		t.Errorf("Delete(referenced) error = %v, want %v", err, domain.ErrProductReferenced)
+	}
+	if _, err := client.Create(ctx, &dto.CreateProductRequest{Price: 10}); !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("Create(invalid) error = %v, want %v", err, domain.ErrValidation)
+	}
+
+	// A 404 for a missing route or a disabled feature is not a missing
+	// product.
+	var statusError *StatusError
+	misrouted := New(server.URL+"/missing", Options{})
+	if _, err := misrouted.GetByID(ctx, 1); errors.Is(err, domain.ErrProductNotFound) || !errors.As(err, &statusError) || statusError.Code != dto.ErrorCodeNotFound {
+		t.Errorf("GetByID() on a missing route error = %v, want a %s StatusError", err, dto.ErrorCodeNotFound)
+	}
+	if _, err := client.FetchIDs(ctx, nil); errors.Is(err, domain.ErrProductNotFound) || !errors.As(err, &statusError) || statusError.StatusCode != 404 {
+		t.Errorf("FetchIDs() while disabled error = %v, want a 404 StatusError", err)
+	}
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// StatusError is returned for responses that do not map to a domain error.
+// Code is the error envelope's code, empty when the body was not one.
+type StatusError struct {
+	StatusCode int
+	Code       string
+	Body       string
+}
+
+func (err *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", err.StatusCode, err.Body)
+}
+
+// errorFromResponse maps the error envelope's code, not the status, to a
+// domain error: a 404 for an unknown route or a disabled feature is not a
+// missing product.
+func errorFromResponse(response *http.Response) error {
+	body, _ := io.ReadAll(response.Body)
+	message := strings.TrimSpace(string(body))
+	if !strings.HasPrefix(response.Header.Get("Content-Type"), "application/json") {
+		return &StatusError{StatusCode: response.StatusCode, Body: message}
+	}
+
+	if response.StatusCode == http.StatusBadRequest {
+		validationError := &dto.ValidationError{}
+		if err := json.Unmarshal(body, validationError); err == nil && len(validationError.Errors) > 0 {
+			return fmt.Errorf("%w: %w", domain.ErrValidation, validationError)
+		}
+	}
+
+	errorResponse := dto.ErrorResponse{}
+	if err := json.Unmarshal(body, &errorResponse); err != nil {
+		return &StatusError{StatusCode: response.StatusCode, Body: message}
+	}
+	switch errorResponse.Code {
+	case dto.ErrorCodeProductNotFound:
+		return domain.ErrProductNotFound
+	case dto.ErrorCodeProductReferenced:
+		return fmt.Errorf("%w: %s", domain.ErrProductReferenced, errorResponse.Message)
+	case dto.ErrorCodePreconditionFailed:
+		return fmt.Errorf("%w: %s", domain.ErrPreconditionFailed, errorResponse.Message)
+	case dto.ErrorCodeBadRequest:
+		return fmt.Errorf("%w: %s", domain.ErrValidation, errorResponse.Message)
+	default:
+		return &StatusError{StatusCode: response.StatusCode, Code: errorResponse.Code, Body: message}
+	}
+}
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
+)
+
+func (client *Client) Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	response := dto.ProductResponse{}
+	if err := client.do(ctx, http.MethodPost, "/product", nil, productRequest, &response); err != nil {
+		return nil, err
+	}
+	return mapper.FromProductResponse(&response), nil
+}
+
+func (client *Client) CreateMany(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error) {
+	response := struct {
+		Created int64 `json:"created"`
+	}{}
+	if err := client.do(ctx, http.MethodPost, "/product/bulk", nil, productRequests, &response); err != nil {
+		return 0, err
+	}
+	return response.Created, nil
+}
+
+func (client *Client) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	response := dto.PaginationResponse[[]dto.ProductResponse]{}
+	if err := client.do(ctx, http.MethodGet, "/product", paginationQuery(pagination), nil, &response); err != nil {
+		return nil, err
+	}
+	return mapper.FromProductPaginationResponse(&response), nil
+}
+
+// GetByIDs returns the products with ids in the order given, leaving out
+// unknown ids.
+func (client *Client) GetByIDs(ctx context.Context, ids []int32) ([]domain.Product, error) {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.Itoa(int(id)))
+	}
+	response := dto.PaginationResponse[[]dto.ProductResponse]{}
+	query := url.Values{"ids": {strings.Join(values, ",")}}
+	if err := client.do(ctx, http.MethodGet, "/product", query, nil, &response); err != nil {
+		return nil, err
+	}
+	return mapper.FromProductResponses(response.Items), nil
+}
+
+func (client *Client) FetchIDs(ctx context.Context, pagination *dto.PaginationRequestParams) ([]int32, error) {
+	response := dto.ProductIDsResponse{}
+	if err := client.do(ctx, http.MethodGet, "/product/ids", paginationQuery(pagination), nil, &response); err != nil {
+		return nil, err
+	}
+	return response.IDs, nil
+}
+
+func (client *Client) Search(ctx context.Context, searchRequest *dto.ProductSearchRequest) (*domain.Pagination[[]domain.Product], error) {
+	response := dto.PaginationResponse[[]dto.ProductResponse]{}
+	if err := client.do(ctx, http.MethodPost, "/product/search", nil, searchRequest, &response); err != nil {
+		return nil, err
+	}
+	return mapper.FromProductPaginationResponse(&response), nil
+}
+
+func (client *Client) FetchFacets(ctx context.Context, search string) (*domain.ProductFacets, error) {
+	query := url.Values{}
+	if search != "" {
+		query.Set("search", search)
+	}
+	facets := domain.ProductFacets{}
+	if err := client.do(ctx, http.MethodGet, "/product/facets", query, nil, &facets); err != nil {
+		return nil, err
+	}
+	return &facets, nil
+}
+
+func (client *Client) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	response := dto.ProductResponse{}
+	if err := client.do(ctx, http.MethodGet, productPath(id), nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return mapper.FromProductResponse(&response), nil
+}
+
+// Upsert replaces the product with id, creating it when absent. The returned
+// bool is true when the server created it.
+func (client *Client) Upsert(ctx context.Context, id int32, productRequest *dto.CreateProductRequest) (*domain.Product, bool, error) {
+	response := dto.ProductResponse{}
+	status, err := client.doStatus(ctx, http.MethodPut, productPath(id), nil, productRequest, &response)
+	if err != nil {
+		return nil, false, err
+	}
+	return mapper.FromProductResponse(&response), status == http.StatusCreated, nil
+}
+
+func (client *Client) GetRelated(ctx context.Context, id int32, limit int) ([]domain.Product, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	response := []dto.ProductResponse{}
+	if err := client.do(ctx, http.MethodGet, productPath(id)+"/related", query, nil, &response); err != nil {
+		return nil, err
+	}
+	return mapper.FromProductResponses(response), nil
+}
+
+func (client *Client) GetPriceHistory(ctx context.Context, id int32) ([]domain.PriceChange, error) {
+	history := []domain.PriceChange{}
+	if err := client.do(ctx, http.MethodGet, productPath(id)+"/price-history", nil, nil, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (client *Client) SchedulePrice(ctx context.Context, id int32, schedulePriceRequest *dto.SchedulePriceRequest) (*domain.PriceSchedule, error) {
+	schedule := domain.PriceSchedule{}
+	if err := client.do(ctx, http.MethodPost, productPath(id)+"/price-schedule", nil, schedulePriceRequest, &schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (client *Client) Delete(ctx context.Context, id int32) error {
+	return client.do(ctx, http.MethodDelete, productPath(id), nil, nil, nil)
+}
+
+func (client *Client) DeleteMany(ctx context.Context, ids []int32) ([]domain.DeleteResult, error) {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.Itoa(int(id)))
+	}
+	response := struct {
+		Results []domain.DeleteResult `json:"results"`
+	}{}
+	query := url.Values{"ids": {strings.Join(values, ",")}}
+	if err := client.do(ctx, http.MethodDelete, "/product", query, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
+
+func (client *Client) AdjustPrices(ctx context.Context, adjustPriceRequest *dto.AdjustPriceRequest) (int64, error) {
+	response := struct {
+		Updated int64 `json:"updated"`
+	}{}
+	if err := client.do(ctx, http.MethodPost, "/product/adjust-price", nil, adjustPriceRequest, &response); err != nil {
+		return 0, err
+	}
+	return response.Updated, nil
+}
+
+// Batch runs mixed operations in one request. Failed operations are reported
+// in the results rather than as an error.
+func (client *Client) Batch(ctx context.Context, batchRequest *dto.BatchRequest) ([]dto.BatchResultResponse, error) {
+	response := struct {
+		Results []dto.BatchResultResponse `json:"results"`
+	}{}
+	if err := client.do(ctx, http.MethodPost, "/product/batch", nil, batchRequest, &response); err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
+
+func productPath(id int32) string {
+	return "/product/" + strconv.Itoa(int(id))
+}
+
+func paginationQuery(pagination *dto.PaginationRequestParams) url.Values {
+	query := url.Values{}
+	if pagination == nil {
+		return query
+	}
+	if pagination.Search != "" {
+		query.Set("search", pagination.Search)
+	}
+	if pagination.Name != "" {
+		query.Set("name", pagination.Name)
+	}
+	if pagination.NameCaseInsensitive {
+		query.Set("caseInsensitive", "true")
+	}
+	if len(pagination.Sort) > 0 {
+		query.Set("sort", strings.Join(pagination.Sort, ","))
+	}
+	if len(pagination.Descending) > 0 {
+		query.Set("descending", strings.Join(pagination.Descending, ","))
+	}
+	if pagination.Page > 0 {
+		query.Set("page", strconv.Itoa(pagination.Page))
+	}
+	if pagination.ItemsPerPage > 0 {
+		query.Set("itemsPerPage", strconv.Itoa(pagination.ItemsPerPage))
+	}
+	if pagination.WithPricing {
+		query.Set("withPricing", "true")
+	}
+	return query
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// Range fetches successive pages starting at pagination.Page and calls yield
+// for each product in order. It stops when yield returns false, after the
+// last page, or on the first error, which it returns. A nil pagination
+// ranges over the whole catalog in pages of dto.MaxItemsPerPage.
+func (client *Client) Range(ctx context.Context, pagination *dto.PaginationRequestParams, yield func(domain.Product) bool) error {
+	params := dto.PaginationRequestParams{}
+	if pagination != nil {
+		params = *pagination
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.ItemsPerPage < 1 {
+		params.ItemsPerPage = dto.MaxItemsPerPage
+	}
+
+	seen := (params.Page - 1) * params.ItemsPerPage
+	for {
+		products, err := client.Fetch(ctx, &params)
+		if err != nil {
+			return err
+		}
+		for _, product := range products.Items {
+			if !yield(product) {
+				return nil
+			}
+		}
+
+		// Only an exact total is trusted to end the range early; estimated
+		// and capped totals can be short, so those run to a short page.
+		seen += len(products.Items)
+		exact := products.CountStrategy == "" || products.CountStrategy == domain.CountStrategyExact
+		if len(products.Items) < params.ItemsPerPage || (exact && seen >= int(products.Total)) {
+			return nil
+		}
+		params.Page++
+	}
+}
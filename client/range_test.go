@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestRange(t *testing.T) {
+	repository := &memoryRepository{}
+	for id := int32(1); id <= 7; id++ {
+		repository.products = append(repository.products, domain.Product{ID: id, Name: "Chair", Price: float32(id), UpdatedAt: updatedAt})
+	}
+	client, _ := newTestClient(t, repository)
+
+	yielded := map[int32]int{}
+	var order []int32
+	err := client.Range(context.Background(), &dto.PaginationRequestParams{ItemsPerPage: 3}, func(product domain.Product) bool {
+		yielded[product.ID]++
+		order = append(order, product.ID)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if len(order) != len(repository.products) {
+		t.Errorf("yielded %v, want ids 1 to %d", order, len(repository.products))
+	}
+	for _, product := range repository.products {
+		if yielded[product.ID] != 1 {
+			t.Errorf("product %d yielded %d times, want 1", product.ID, yielded[product.ID])
+		}
+	}
+	if repository.pagination.Page != 3 {
+		t.Errorf("last page fetched = %d, want 3", repository.pagination.Page)
+	}
+
+	var stoppedAt []int32
+	err = client.Range(context.Background(), &dto.PaginationRequestParams{ItemsPerPage: 3}, func(product domain.Product) bool {
+		stoppedAt = append(stoppedAt, product.ID)
+		return product.ID < 4
+	})
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if len(stoppedAt) != 4 || repository.pagination.Page != 2 {
+		t.Errorf("stopping early yielded %v up to page %d, want 4 products from 2 pages", stoppedAt, repository.pagination.Page)
+	}
+}
@@ -0,0 +1,19 @@
+package domain
+
+const (
+	BatchStatusOK         = "ok"
+	BatchStatusFailed     = "failed"
+	BatchStatusRolledBack = "rolled_back"
+	BatchStatusSkipped    = "skipped"
+)
+
+// BatchResult reports one operation of a batch, in request order. Product
+// is the created or updated product.
+type BatchResult struct {
+	Index   int
+	Op      string
+	ID      int32
+	Status  string
+	Product *Product
+	Error   string
+}
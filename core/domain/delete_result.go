@@ -0,0 +1,14 @@
+package domain
+
+const (
+	DeleteStatusDeleted    = "deleted"
+	DeleteStatusNotFound   = "not_found"
+	DeleteStatusReferenced = "referenced"
+	DeleteStatusError      = "error"
+)
+
+type DeleteResult struct {
+	ID     int32  `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+const (
+	DiscountTypePercentage = "percentage"
+	DiscountTypeFixed      = "fixed"
+)
+
+type Discount struct {
+	Type     string     `json:"type"`
+	Value    float32    `json:"value"`
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+	EndsAt   *time.Time `json:"endsAt,omitempty"`
+}
+
+func (discount Discount) ActiveAt(now time.Time) bool {
+	if discount.StartsAt != nil && now.Before(*discount.StartsAt) {
+		return false
+	}
+	if discount.EndsAt != nil && !now.Before(*discount.EndsAt) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+var (
+	ErrProductNotFound   = errors.New("product not found")
+	ErrValidation        = errors.New("validation failed")
+	ErrProductReferenced = errors.New("product is still referenced")
+	// ErrPreconditionFailed reports a conditional write whose product changed,
+	// or disappeared, since the version it was conditioned on was read.
+	ErrPreconditionFailed = errors.New("product was modified concurrently")
+)
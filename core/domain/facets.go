@@ -0,0 +1,13 @@
+package domain
+
+var PriceFacetBounds = []float32{10, 50, 100, 500}
+
+type PriceFacet struct {
+	Min   float32  `json:"min"`
+	Max   *float32 `json:"max,omitempty"`
+	Count int32    `json:"count"`
+}
+
+type ProductFacets struct {
+	PriceRanges []PriceFacet `json:"priceRanges"`
+}
@@ -1,6 +1,24 @@
 package domain
 
+const (
+	CountStrategyExact    = "exact"
+	CountStrategyEstimate = "estimate"
+	CountStrategyCap      = "cap"
+)
+
 type Pagination[T any] struct {
-	Items T     `json:"items"`
-	Total int32 `json:"total"`
+	Items         T      `json:"items"`
+	Total         int32  `json:"total"`
+	CountStrategy string `json:"countStrategy,omitempty"`
+	TotalCapped   bool   `json:"totalCapped,omitempty"`
+	// OutOfRange marks an empty page past the end of a non-empty result,
+	// telling it apart from a result with no matches at all.
+	OutOfRange bool `json:"outOfRange,omitempty"`
+	Stale      bool `json:"-"`
+}
+
+// MarkOutOfRange sets OutOfRange when page, of size itemsPerPage, holds no
+// items because it starts past Total.
+func (pagination *Pagination[T]) MarkOutOfRange(page int, itemsPerPage int, items int) {
+	pagination.OutOfRange = items == 0 && pagination.Total > 0 && (page-1)*itemsPerPage >= int(pagination.Total)
 }
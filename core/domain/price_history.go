@@ -0,0 +1,8 @@
+package domain
+
+import "time"
+
+type PriceChange struct {
+	Price     float32   `json:"price"`
+	ChangedAt time.Time `json:"changedAt"`
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+type PriceSchedule struct {
+	ID          int32     `json:"id"`
+	ProductID   int32     `json:"productId"`
+	NewPrice    float32   `json:"newPrice"`
+	EffectiveAt time.Time `json:"effectiveAt"`
+}
@@ -1,29 +1,94 @@
 package domain
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gabriwl165/clean-arch-go/core/dto"
 )
 
 type Product struct {
-	ID          int32   `json:"id"`
-	Name        string  `json:"name"`
-	Price       float32 `json:"price"`
-	Description string  `json:"description"`
+	ID             int32     `json:"id"`
+	Name           string    `json:"name"`
+	Price          float32   `json:"price"`
+	Description    string    `json:"description"`
+	Discount       *Discount `json:"discount,omitempty"`
+	EffectivePrice *float32  `json:"effectivePrice,omitempty"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+	Deleted        bool      `json:"deleted,omitempty"`
+	Stale          bool      `json:"-"`
 }
 
 type ProductService interface {
 	Create(response http.ResponseWriter, request *http.Request)
+	CreateMany(response http.ResponseWriter, request *http.Request)
 	Fetch(response http.ResponseWriter, request *http.Request)
+	Count(response http.ResponseWriter, request *http.Request)
+	Search(response http.ResponseWriter, request *http.Request)
+	FetchFacets(response http.ResponseWriter, request *http.Request)
+	FetchIDs(response http.ResponseWriter, request *http.Request)
+	GetByID(response http.ResponseWriter, request *http.Request)
+	SchedulePrice(response http.ResponseWriter, request *http.Request)
+	GetRelated(response http.ResponseWriter, request *http.Request)
+	GetPriceHistory(response http.ResponseWriter, request *http.Request)
+	Delete(response http.ResponseWriter, request *http.Request)
+	DeleteMany(response http.ResponseWriter, request *http.Request)
+	Upsert(response http.ResponseWriter, request *http.Request)
+	AdjustPrices(response http.ResponseWriter, request *http.Request)
+	Batch(response http.ResponseWriter, request *http.Request)
+	Schema(response http.ResponseWriter, request *http.Request)
 }
 
 type ProductUseCase interface {
-	Create(productRequest *dto.CreateProductRequest) (*Product, error)
-	Fetch(paginationRequest *dto.PaginationRequestParams) (*Pagination[[]Product], error)
+	Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*Product, error)
+	CreateID(ctx context.Context, productRequest *dto.CreateProductRequest) (int32, error)
+	CreateMany(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error)
+	Preview(ctx context.Context, productRequest *dto.CreateProductRequest) (*Product, error)
+	PreviewMany(ctx context.Context, productRequests []*dto.CreateProductRequest) ([]Product, error)
+	Fetch(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*Pagination[[]Product], error)
+	Count(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*Pagination[[]Product], error)
+	Search(ctx context.Context, searchRequest *dto.ProductSearchRequest) (*Pagination[[]Product], error)
+	FetchFacets(ctx context.Context, search string) (*ProductFacets, error)
+	FetchIDs(ctx context.Context, paginationRequest *dto.PaginationRequestParams) ([]int32, error)
+	FetchLastModified(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*time.Time, error)
+	FetchUpdatedSince(ctx context.Context, since time.Time, paginationRequest *dto.PaginationRequestParams) (*Pagination[[]Product], error)
+	GetByID(ctx context.Context, id int32) (*Product, error)
+	GetByIDs(ctx context.Context, ids []int32) ([]Product, error)
+	GetRelated(ctx context.Context, id int32, limit int) ([]Product, error)
+	GetPriceHistory(ctx context.Context, productID int32) ([]PriceChange, error)
+	SchedulePrice(ctx context.Context, productID int32, schedulePriceRequest *dto.SchedulePriceRequest) (*PriceSchedule, error)
+	ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int64, error)
+	Delete(ctx context.Context, id int32) error
+	DeleteMany(ctx context.Context, ids []int32) ([]DeleteResult, error)
+	Upsert(ctx context.Context, id int32, productRequest *dto.CreateProductRequest) (*Product, bool, error)
+	UpdateIfUnmodified(ctx context.Context, id int32, updatedAt time.Time, productRequest *dto.CreateProductRequest) (*Product, error)
+	AdjustPrices(ctx context.Context, adjustPriceRequest *dto.AdjustPriceRequest) (int64, error)
+	Batch(ctx context.Context, batchRequest *dto.BatchRequest) ([]BatchResult, error)
+	EffectivePrice(product *Product, now time.Time) float32
 }
 
 type ProductRepository interface {
-	Create(productRequest *dto.CreateProductRequest) (*Product, error)
-	Fetch(paginationRequest *dto.PaginationRequestParams) (*Pagination[[]Product], error)
+	Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*Product, error)
+	CreateID(ctx context.Context, productRequest *dto.CreateProductRequest) (int32, error)
+	CreateManyCopy(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error)
+	Fetch(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*Pagination[[]Product], error)
+	Count(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*Pagination[[]Product], error)
+	Search(ctx context.Context, searchRequest *dto.ProductSearchRequest) (*Pagination[[]Product], error)
+	FetchFacets(ctx context.Context, search string) (*ProductFacets, error)
+	FetchIDs(ctx context.Context, paginationRequest *dto.PaginationRequestParams) ([]int32, error)
+	FetchLastModified(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*time.Time, error)
+	FetchUpdatedSince(ctx context.Context, since time.Time, paginationRequest *dto.PaginationRequestParams) (*Pagination[[]Product], error)
+	GetByID(ctx context.Context, id int32) (*Product, error)
+	GetByIDs(ctx context.Context, ids []int32) ([]Product, error)
+	GetRelated(ctx context.Context, id int32, limit int) ([]Product, error)
+	GetPriceHistory(ctx context.Context, productID int32) ([]PriceChange, error)
+	SchedulePrice(ctx context.Context, productID int32, schedulePriceRequest *dto.SchedulePriceRequest) (*PriceSchedule, error)
+	ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int64, error)
+	Delete(ctx context.Context, id int32) error
+	DeleteMany(ctx context.Context, ids []int32) ([]DeleteResult, error)
+	Upsert(ctx context.Context, id int32, productRequest *dto.CreateProductRequest) (*Product, bool, error)
+	UpdateIfUnmodified(ctx context.Context, id int32, updatedAt time.Time, productRequest *dto.CreateProductRequest) (*Product, error)
+	AdjustPrices(ctx context.Context, adjustPriceRequest *dto.AdjustPriceRequest) (int64, error)
+	Batch(ctx context.Context, batchRequest *dto.BatchRequest) ([]BatchResult, error)
 }
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"bytes"
+	"io"
+)
+
+const (
+	BatchOpCreate = "create"
+	BatchOpUpdate = "update"
+	BatchOpDelete = "delete"
+
+	DefaultMaxBatchSize = 1000
+)
+
+// BatchOperation is one step of a batch. Create takes a product, update
+// takes an id and the full replacement product, and delete takes an id.
+type BatchOperation struct {
+	Op      string                `json:"op"`
+	ID      int32                 `json:"id,omitempty"`
+	Product *CreateProductRequest `json:"product,omitempty"`
+}
+
+// BatchRequest runs operations in order inside one transaction. By default
+// any failure rolls back the whole batch; with AllowPartial only the failed
+// operation is undone and the rest are committed.
+type BatchRequest struct {
+	Operations   []BatchOperation `json:"operations"`
+	AllowPartial bool             `json:"allowPartial"`
+}
+
+type BatchResultResponse struct {
+	Index   int              `json:"index"`
+	Op      string           `json:"op"`
+	ID      int32            `json:"id,omitempty"`
+	Status  string           `json:"status"`
+	Product *ProductResponse `json:"product,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
+
+func FromJSONBatchRequest(body io.Reader, strict bool, limits JSONLimits) (*BatchRequest, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if err := limits.Check(data); err != nil {
+		return nil, err
+	}
+
+	batchRequest := BatchRequest{}
+	if err := newDecoder(bytes.NewReader(data), strict).Decode(&batchRequest); err != nil {
+		return nil, err
+	}
+	return &batchRequest, nil
+}
@@ -0,0 +1,7 @@
+package dto
+
+// CreatedProductResponse is the body of a create answered with
+// "Prefer: return=minimal".
+type CreatedProductResponse struct {
+	ID int32 `json:"id"`
+}
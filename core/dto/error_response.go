@@ -0,0 +1,23 @@
+package dto
+
+const (
+	ErrorCodeBadRequest         = "BAD_REQUEST"
+	ErrorCodeUnauthorized       = "UNAUTHORIZED"
+	ErrorCodeNotFound           = "NOT_FOUND"
+	ErrorCodeMethodNotAllowed   = "METHOD_NOT_ALLOWED"
+	ErrorCodeConflict           = "CONFLICT"
+	ErrorCodePreconditionFailed = "PRECONDITION_FAILED"
+	ErrorCodeInternal           = "INTERNAL"
+	ErrorCodeTimeout            = "TIMEOUT"
+	// The product codes tell a missing or referenced product apart from a
+	// missing route or another conflict answered with the same status.
+	ErrorCodeProductNotFound   = "PRODUCT_NOT_FOUND"
+	ErrorCodeProductReferenced = "PRODUCT_REFERENCED"
+)
+
+// ErrorResponse is the envelope of every error response except field
+// validation failures, which are written as a ValidationError.
+type ErrorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
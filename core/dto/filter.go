@@ -0,0 +1,87 @@
+package dto
+
+import "fmt"
+
+const (
+	MaxFilterDepth = 5
+	MaxFilterNodes = 100
+)
+
+var filterOperators = map[string][]string{
+	"id":          {"eq", "ne", "lt", "lte", "gt", "gte"},
+	"price":       {"eq", "ne", "lt", "lte", "gt", "gte"},
+	"name":        {"eq", "ne", "contains"},
+	"description": {"eq", "ne", "contains"},
+}
+
+type FilterNode struct {
+	And   []FilterNode `json:"and,omitempty"`
+	Or    []FilterNode `json:"or,omitempty"`
+	Field string       `json:"field,omitempty"`
+	Op    string       `json:"op,omitempty"`
+	Value any          `json:"value,omitempty"`
+}
+
+func (node *FilterNode) IsLeaf() bool {
+	return len(node.And) == 0 && len(node.Or) == 0
+}
+
+func (node *FilterNode) validate(path string, depth int, nodes *int, validationError *ValidationError) {
+	*nodes++
+	if depth > MaxFilterDepth {
+		validationError.Add(path, "maxdepth", fmt.Sprintf("must not be nested deeper than %d levels", MaxFilterDepth))
+		return
+	}
+	if *nodes > MaxFilterNodes {
+		validationError.Add(path, "maxnodes", fmt.Sprintf("filter must not contain more than %d conditions", MaxFilterNodes))
+		return
+	}
+
+	if len(node.And) > 0 && len(node.Or) > 0 {
+		validationError.Add(path, "exclusive", "must not combine and with or in the same node")
+		return
+	}
+	if !node.IsLeaf() {
+		if node.Field != "" || node.Op != "" || node.Value != nil {
+			validationError.Add(path, "exclusive", "must not combine a condition with and/or")
+		}
+		for i := range node.And {
+			node.And[i].validate(fmt.Sprintf("%s.and[%d]", path, i), depth+1, nodes, validationError)
+		}
+		for i := range node.Or {
+			node.Or[i].validate(fmt.Sprintf("%s.or[%d]", path, i), depth+1, nodes, validationError)
+		}
+		return
+	}
+
+	operators, ok := filterOperators[node.Field]
+	if !ok {
+		validationError.Add(path+".field", "oneof", "must be one of id, name, description or price")
+		return
+	}
+	if !containsString(operators, node.Op) {
+		validationError.Add(path+".op", "oneof", fmt.Sprintf("is not supported for %s", node.Field))
+		return
+	}
+	switch node.Value.(type) {
+	case string:
+		if node.Field == "id" || node.Field == "price" {
+			validationError.Add(path+".value", "type", "must be a number")
+		}
+	case float64:
+		if node.Field == "name" || node.Field == "description" {
+			validationError.Add(path+".value", "type", "must be a string")
+		}
+	default:
+		validationError.Add(path+".value", "required", "must be a string or number")
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"errors"
+	"testing"
+)
+
+// nestedFilter wraps a single condition in depth-1 alternating and/or groups.
+func nestedFilter(depth int) *FilterNode {
+	node := FilterNode{Field: "price", Op: "lt", Value: float64(100)}
+	for level := 1; level < depth; level++ {
+		if level%2 == 0 {
+			node = FilterNode{And: []FilterNode{node}}
+		} else {
+			node = FilterNode{Or: []FilterNode{node}}
+		}
+	}
+	return &node
+}
+
+func TestFilterValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   *FilterNode
+		wantRule string
+	}{
+		{
+			name: "mixed and/or",
+			filter: &FilterNode{And: []FilterNode{
+				{Or: []FilterNode{{Field: "name", Op: "contains", Value: "x"}, {Field: "description", Op: "eq", Value: "y"}}},
+				{Field: "price", Op: "lt", Value: float64(100)},
+			}},
+		},
+		{name: "at the depth limit", filter: nestedFilter(MaxFilterDepth)},
+		{name: "past the depth limit", filter: nestedFilter(MaxFilterDepth + 1), wantRule: "maxdepth"},
+		{name: "and with or in one node", filter: &FilterNode{And: []FilterNode{{Field: "id", Op: "eq", Value: float64(1)}}, Or: []FilterNode{{Field: "id", Op: "eq", Value: float64(2)}}}, wantRule: "exclusive"},
+		{name: "column outside the allowlist", filter: &FilterNode{Field: "price; DROP TABLE product", Op: "eq", Value: float64(1)}, wantRule: "oneof"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := (&ProductSearchRequest{Filter: test.filter}).Validate()
+			if test.wantRule == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			var validationError *ValidationError
+			if !errors.As(err, &validationError) || len(validationError.Errors) != 1 || validationError.Errors[0].Rule != test.wantRule {
+				t.Errorf("Validate() error = %v, want a single %s failure", err, test.wantRule)
+			}
+		})
+	}
+}
+
+func TestFilterValidationLimitsNodes(t *testing.T) {
+	conditions := make([]FilterNode, MaxFilterNodes)
+	for i := range conditions {
+		conditions[i] = FilterNode{Field: "id", Op: "ne", Value: float64(i)}
+	}
+
+	err := (&ProductSearchRequest{Filter: &FilterNode{Or: conditions}}).Validate()
+
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) || validationError.Errors[0].Rule != "maxnodes" {
+		t.Errorf("Validate() error = %v, want a maxnodes failure", err)
+	}
+}
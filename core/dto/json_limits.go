@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// JSONLimits bounds the shape of a JSON document before it is decoded. A
+// zero field disables that check.
+type JSONLimits struct {
+	MaxDepth       int
+	MaxArrayLength int
+}
+
+func (limits JSONLimits) Check(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// Each entry is the element count of an open array, or -1 for an object.
+	stack := []int{}
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		delim, isDelim := token.(json.Delim)
+		if isDelim && (delim == '}' || delim == ']') {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		if top := len(stack) - 1; top >= 0 && stack[top] >= 0 {
+			stack[top]++
+			if limits.MaxArrayLength > 0 && stack[top] > limits.MaxArrayLength {
+				return fmt.Errorf("json array exceeds the maximum length of %d", limits.MaxArrayLength)
+			}
+		}
+
+		if !isDelim {
+			continue
+		}
+		if delim == '[' {
+			stack = append(stack, 0)
+		} else {
+			stack = append(stack, -1)
+		}
+		if limits.MaxDepth > 0 && len(stack) > limits.MaxDepth {
+			return fmt.Errorf("json nesting exceeds the maximum depth of %d", limits.MaxDepth)
+		}
+	}
+}
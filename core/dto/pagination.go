@@ -1,28 +1,191 @@
 package dto
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 )
 
+const (
+	DefaultItemsPerPage = 10
+	MaxItemsPerPage     = 100
+)
+
+var SortableProductFields = []string{"id", "name", "price", "description"}
+
 type PaginationRequestParams struct {
-	Search       string   `json:"search"`
-	Descending   []string `json:"descending"`
-	Page         int      `json:"page"`
-	ItemsPerPage int      `json:"itemsPerPage"`
-	Sort         []string `json:"sort"`
+	Search              string   `json:"search"`
+	Name                string   `json:"name"`
+	NameCaseInsensitive bool     `json:"caseInsensitive"`
+	Descending          []string `json:"descending"`
+	Page                int      `json:"page"`
+	ItemsPerPage        int      `json:"itemsPerPage"`
+	Sort                []string `json:"sort"`
+	// WithPricing only shapes the response, so it is left out of the keys
+	// derived from the JSON form.
+	WithPricing bool `json:"-"`
 }
 
 func FromValuePaginationRequestParams(request *http.Request) (*PaginationRequestParams, error) {
-	page, _ := strconv.Atoi(request.FormValue("page"))
-	itemsPerPage, _ := strconv.Atoi(request.FormValue("itemsPerPage"))
+	page, itemsPerPage, err := parsePage(request)
+	if err != nil {
+		return nil, err
+	}
+	descending, err := parseSortDirections(request)
+	if err != nil {
+		return nil, err
+	}
+	caseInsensitive := false
+	if value := request.FormValue("caseInsensitive"); value != "" {
+		caseInsensitive, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid caseInsensitive value %q: expected true or false", value)
+		}
+	}
+	withPricing, err := FromValueWithPricing(request)
+	if err != nil {
+		return nil, err
+	}
 	paginationRequestParams := PaginationRequestParams{
-		Search:       request.FormValue("search"),
-		Descending:   strings.Split(request.FormValue("descending"), ","),
-		Sort:         strings.Split(request.FormValue("sort"), ","),
-		Page:         page,
-		ItemsPerPage: itemsPerPage,
+		Search:              request.FormValue("search"),
+		Name:                request.FormValue("name"),
+		NameCaseInsensitive: caseInsensitive,
+		Descending:          descending,
+		Sort:                strings.Split(request.FormValue("sort"), ","),
+		Page:                page,
+		ItemsPerPage:        itemsPerPage,
+		WithPricing:         withPricing,
 	}
+	paginationRequestParams.Normalize()
 	return &paginationRequestParams, nil
 }
+
+// DefaultPaginationRequestParams returns the parameters of an unfiltered
+// first-page listing, as parsed from a request without query parameters.
+func DefaultPaginationRequestParams() *PaginationRequestParams {
+	params := &PaginationRequestParams{}
+	params.Normalize()
+	return params
+}
+
+// parsePage accepts either page/itemsPerPage or offset/limit. Offsets are
+// converted to a page, so they must fall on a page boundary.
+func parsePage(request *http.Request) (int, int, error) {
+	offsetValue, limitValue := request.FormValue("offset"), request.FormValue("limit")
+	if offsetValue == "" && limitValue == "" {
+		page, _ := strconv.Atoi(request.FormValue("page"))
+		itemsPerPage, _ := strconv.Atoi(request.FormValue("itemsPerPage"))
+		return page, itemsPerPage, nil
+	}
+	if request.FormValue("page") != "" || request.FormValue("itemsPerPage") != "" {
+		return 0, 0, fmt.Errorf("use either page and itemsPerPage or offset and limit, not both")
+	}
+
+	offset, limit := 0, DefaultItemsPerPage
+	var err error
+	if offsetValue != "" {
+		if offset, err = strconv.Atoi(offsetValue); err != nil || offset < 0 {
+			return 0, 0, fmt.Errorf("invalid offset value %q: expected a non-negative integer", offsetValue)
+		}
+	}
+	if limitValue != "" {
+		if limit, err = strconv.Atoi(limitValue); err != nil || limit < 1 || limit > MaxItemsPerPage {
+			return 0, 0, fmt.Errorf("invalid limit value %q: expected an integer between 1 and %d", limitValue, MaxItemsPerPage)
+		}
+	}
+	if offset%limit != 0 {
+		return 0, 0, fmt.Errorf("offset %d must be a multiple of limit %d", offset, limit)
+	}
+	return offset/limit + 1, limit, nil
+}
+
+// parseSortDirections reads the per-column directions from direction
+// (asc/desc) or, for older clients, descending (true/false), but not both.
+func parseSortDirections(request *http.Request) ([]string, error) {
+	value := request.FormValue("direction")
+	if value == "" {
+		return parseDescending(request.FormValue("descending"))
+	}
+	if request.FormValue("descending") != "" {
+		return nil, fmt.Errorf("use either direction or descending, not both")
+	}
+
+	descending := []string{}
+	for _, field := range strings.Split(value, ",") {
+		direction, err := ParseSortDirection(field)
+		if err != nil {
+			return nil, err
+		}
+		descending = append(descending, strconv.FormatBool(direction.IsDescending()))
+	}
+	return descending, nil
+}
+
+func parseDescending(value string) ([]string, error) {
+	descending := []string{}
+	if value == "" {
+		return descending, nil
+	}
+	for _, flag := range strings.Split(value, ",") {
+		switch strings.TrimSpace(flag) {
+		case "true", "1":
+			descending = append(descending, "true")
+		case "false", "0", "":
+			descending = append(descending, "false")
+		default:
+			return nil, fmt.Errorf("invalid descending value %q: expected true, false, 1 or 0", flag)
+		}
+	}
+	return descending, nil
+}
+
+func (params *PaginationRequestParams) Normalize() {
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.ItemsPerPage < 1 {
+		params.ItemsPerPage = DefaultItemsPerPage
+	}
+	if params.ItemsPerPage > MaxItemsPerPage {
+		params.ItemsPerPage = MaxItemsPerPage
+	}
+
+	sort := []string{}
+	descending := []string{}
+	for i, field := range params.Sort {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		sort = append(sort, field)
+		if i < len(params.Descending) {
+			descending = append(descending, strings.TrimSpace(params.Descending[i]))
+		} else {
+			descending = append(descending, "false")
+		}
+	}
+	params.Sort = sort
+	params.Descending = descending
+}
+
+// Validate rejects sort columns outside SortableProductFields. Normalize keeps
+// them so a misspelled column is reported rather than silently ignored.
+func (params *PaginationRequestParams) Validate() error {
+	validationError := &ValidationError{}
+	for i, field := range params.Sort {
+		if !isSortableProductField(field) {
+			validationError.Add(fmt.Sprintf("sort[%d]", i), "oneof", "must be one of the sortable product fields")
+		}
+	}
+	return validationError.OrNil()
+}
+
+func isSortableProductField(field string) bool {
+	for _, sortable := range SortableProductFields {
+		if field == sortable {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func FuzzParsePaginationParams(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"page=-1&itemsPerPage=-5",
+		"page=99999999999999999999&itemsPerPage=99999999999999999999",
+		"offset=9223372036854775807&limit=1",
+		"sort=name,price&descending=true",
+		"sort=name&descending=true,false,true",
+		"sort=name,,price&direction=desc",
+		"sort=name&direction=desc&descending=true",
+		"offset=20&page=2",
+		"offset=10&limit=3",
+		"sort=;DROP TABLE product",
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, query string) {
+		request := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/product", RawQuery: query}}
+		params, err := FromValuePaginationRequestParams(request)
+		if err != nil {
+			return
+		}
+		if params.Page < 1 {
+			t.Errorf("page = %d, want at least 1", params.Page)
+		}
+		if params.ItemsPerPage < 1 || params.ItemsPerPage > MaxItemsPerPage {
+			t.Errorf("itemsPerPage = %d, want between 1 and %d", params.ItemsPerPage, MaxItemsPerPage)
+		}
+		if len(params.Descending) != len(params.Sort) {
+			t.Errorf("descending = %v, want one direction per sort field %v", params.Descending, params.Sort)
+		}
+		for i, field := range params.Sort {
+			if field == "" {
+				t.Errorf("sort[%d] is empty", i)
+			}
+			if !isSortableProductField(field) && params.Validate() == nil {
+				t.Errorf("sort[%d] = %q is not sortable but Validate accepted it", i, field)
+			}
+		}
+	})
+}
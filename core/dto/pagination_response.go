@@ -0,0 +1,9 @@
+package dto
+
+type PaginationResponse[T any] struct {
+	Items         T      `json:"items"`
+	Total         int32  `json:"total"`
+	CountStrategy string `json:"countStrategy,omitempty"`
+	TotalCapped   bool   `json:"totalCapped,omitempty"`
+	OutOfRange    bool   `json:"outOfRange,omitempty"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParsePage(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		wantPage         int
+		wantItemsPerPage int
+		wantErr          bool
+	}{
+		{name: "page based", query: "page=3&itemsPerPage=20", wantPage: 3, wantItemsPerPage: 20},
+		{name: "offset based", query: "offset=40&limit=20", wantPage: 3, wantItemsPerPage: 20},
+		{name: "offset without limit", query: "offset=20", wantPage: 3, wantItemsPerPage: DefaultItemsPerPage},
+		{name: "limit without offset", query: "limit=25", wantPage: 1, wantItemsPerPage: 25},
+		{name: "offset off a page boundary", query: "offset=10&limit=3", wantErr: true},
+		{name: "negative offset", query: "offset=-10&limit=10", wantErr: true},
+		{name: "limit too large", query: "limit=101", wantErr: true},
+		{name: "both forms", query: "page=2&itemsPerPage=10&offset=10&limit=10", wantErr: true},
+		{name: "page with offset", query: "page=2&offset=10", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/product", RawQuery: test.query}}
+
+			page, itemsPerPage, err := parsePage(request)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("parsePage() error = %v, want error = %v", err, test.wantErr)
+			}
+			if page != test.wantPage || itemsPerPage != test.wantItemsPerPage {
+				t.Errorf("parsePage() = %d, %d, want %d, %d", page, itemsPerPage, test.wantPage, test.wantItemsPerPage)
+			}
+		})
+	}
+}
+
+func TestParseSortDirections(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "missing", query: "", want: []string{}},
+		{name: "true", query: "descending=true", want: []string{"true"}},
+		{name: "false", query: "descending=false", want: []string{"false"}},
+		{name: "one and zero", query: "descending=1,0", want: []string{"true", "false"}},
+		{name: "empty entry", query: "descending=true,,1", want: []string{"true", "false", "true"}},
+		{name: "yes", query: "descending=yes", wantErr: true},
+		{name: "capitalized", query: "descending=TRUE", wantErr: true},
+		{name: "direction", query: "direction=desc,asc", want: []string{"true", "false"}},
+		{name: "invalid direction", query: "direction=down", wantErr: true},
+		{name: "direction and descending", query: "direction=desc&descending=true", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/product", RawQuery: test.query}}
+
+			descending, err := parseSortDirections(request)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("parseSortDirections() error = %v, want error = %v", err, test.wantErr)
+			}
+			if !test.wantErr && !reflect.DeepEqual(descending, test.want) {
+				t.Errorf("parseSortDirections() = %v, want %v", descending, test.want)
+			}
+		})
+	}
+}
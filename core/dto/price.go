@@ -0,0 +1,11 @@
+package dto
+
+import "strconv"
+
+// Price is a response amount that always encodes with two decimal places,
+// so a stored 19.99 is written as 19.99 rather than its float32 expansion.
+type Price float32
+
+func (price Price) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatFloat(float64(price), 'f', 2, 32)), nil
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Percentages are bounded so a typo cannot wipe out or explode the catalog;
+// anything at or below -100% would leave prices at zero or negative.
+const (
+	MinPriceAdjustmentPercentage = -90
+	MaxPriceAdjustmentPercentage = 1000
+)
+
+type AdjustPriceRequest struct {
+	Percentage   float32     `json:"percentage"`
+	NameContains string      `json:"nameContains"`
+	Filter       *FilterNode `json:"filter"`
+}
+
+func FromJSONAdjustPriceRequest(body io.Reader, limits JSONLimits) (*AdjustPriceRequest, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if err := limits.Check(data); err != nil {
+		return nil, err
+	}
+
+	// Always strict: a misspelt filter key on a bulk write must not be
+	// silently dropped.
+	adjustPriceRequest := AdjustPriceRequest{}
+	if err := newDecoder(bytes.NewReader(data), true).Decode(&adjustPriceRequest); err != nil {
+		return nil, err
+	}
+	return &adjustPriceRequest, nil
+}
+
+func (request *AdjustPriceRequest) Validate() error {
+	validationError := &ValidationError{}
+	if request.Percentage == 0 {
+		validationError.Add("percentage", "required", "is required")
+	} else if request.Percentage < MinPriceAdjustmentPercentage || request.Percentage > MaxPriceAdjustmentPercentage {
+		validationError.Add("percentage", "between", fmt.Sprintf("must be between %d and %d", MinPriceAdjustmentPercentage, MaxPriceAdjustmentPercentage))
+	}
+	if request.NameContains == "" && request.Filter == nil {
+		validationError.Add("filter", "required", "nameContains or filter is required")
+	}
+	if request.Filter != nil {
+		nodes := 0
+		request.Filter.validate("filter", 1, &nodes, validationError)
+	}
+	return validationError.OrNil()
+}
+
+// Factor is the multiplier applied to each matching price.
+func (request *AdjustPriceRequest) Factor() float64 {
+	return 1 + float64(request.Percentage)/100
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+type SchedulePriceRequest struct {
+	NewPrice    float32   `json:"newPrice"`
+	EffectiveAt time.Time `json:"effectiveAt"`
+}
+
+// FromJSONSchedulePriceRequest decodes a price schedule. An empty body decodes
+// to the zero request, which Validate rejects.
+func FromJSONSchedulePriceRequest(body io.Reader, strict bool) (*SchedulePriceRequest, error) {
+	schedulePriceRequest := SchedulePriceRequest{}
+	if err := newDecoder(body, strict).Decode(&schedulePriceRequest); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &schedulePriceRequest, nil
+}
+
+func (request *SchedulePriceRequest) Validate() error {
+	validationError := &ValidationError{}
+	if request.NewPrice <= 0 {
+		validationError.Add("newPrice", "gt", "must be greater than 0")
+	}
+	if request.EffectiveAt.IsZero() {
+		validationError.Add("effectiveAt", "required", "is required")
+	}
+	return validationError.OrNil()
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestPriceMarshalJSON(t *testing.T) {
+	tests := []struct {
+		price float32
+		want  string
+	}{
+		{price: 19.99, want: "19.99"},
+		{price: 10, want: "10.00"},
+		{price: 0.1, want: "0.10"},
+		{price: 0.3, want: "0.30"},
+		{price: 1999.5, want: "1999.50"},
+	}
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			encoded, err := json.Marshal(Price(test.price))
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(encoded) != test.want {
+				t.Errorf("Marshal(%v) = %s, want %s", test.price, encoded, test.want)
+			}
+		})
+	}
+}
+
+func TestProductResponsePrice(t *testing.T) {
+	effectivePrice := Price(17.99)
+	encoded, err := json.Marshal(ProductResponse{ID: 1, Name: "Lamp", Price: 19.99, EffectivePrice: &effectivePrice})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, want := range []string{`"price":19.99`, `"effectivePrice":17.99`} {
+		if !strings.Contains(string(encoded), want) {
+			t.Errorf("Marshal() = %s, want it to contain %s", encoded, want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type pricingKey struct{}
+
+// FromValueWithPricing parses the withPricing query parameter, which asks for
+// the effective price of every product in the response.
+func FromValueWithPricing(request *http.Request) (bool, error) {
+	value := request.FormValue("withPricing")
+	if value == "" {
+		return false, nil
+	}
+	withPricing, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid withPricing value %q: expected true or false", value)
+	}
+	return withPricing, nil
+}
+
+// ContextWithPricing records in ctx whether the request asked for effective
+// prices, so use cases without a request DTO can honor it.
+func ContextWithPricing(ctx context.Context, withPricing bool) context.Context {
+	return context.WithValue(ctx, pricingKey{}, withPricing)
+}
+
+func PricingRequested(ctx context.Context) bool {
+	withPricing, _ := ctx.Value(pricingKey{}).(bool)
+	return withPricing
+}
@@ -2,19 +2,127 @@ package dto
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"time"
 )
 
+const (
+	MaxProductNameLength        = 50
+	MaxProductDescriptionLength = 500
+)
+
+type DiscountRequest struct {
+	Type     string     `json:"type"`
+	Value    float32    `json:"value"`
+	StartsAt *time.Time `json:"startsAt"`
+	EndsAt   *time.Time `json:"endsAt"`
+}
+
 type CreateProductRequest struct {
-	Name        string  `json:"name"`
-	Price       float32 `json:"price"`
-	Description string  `json:"description"`
+	Name        string           `json:"name"`
+	Price       float32          `json:"price"`
+	Description string           `json:"description"`
+	Discount    *DiscountRequest `json:"discount"`
 }
 
-func FromJSONCreateProductRequest(body io.Reader) (*CreateProductRequest, error) {
+// FromJSONCreateProductRequest decodes a single product. In strict mode
+// unknown fields are rejected instead of silently ignored.
+func FromJSONCreateProductRequest(body io.Reader, strict bool) (*CreateProductRequest, error) {
 	createProductRequest := CreateProductRequest{}
-	if err := json.NewDecoder(body).Decode(&createProductRequest); err != nil {
+	if err := newDecoder(body, strict).Decode(&createProductRequest); err != nil {
 		return nil, err
 	}
 	return &createProductRequest, nil
 }
+
+func FromJSONCreateProductsRequest(body io.Reader, strict bool) ([]*CreateProductRequest, error) {
+	createProductRequests := []*CreateProductRequest{}
+	if err := newDecoder(body, strict).Decode(&createProductRequests); err != nil {
+		return nil, err
+	}
+	return createProductRequests, nil
+}
+
+func newDecoder(body io.Reader, strict bool) *json.Decoder {
+	decoder := json.NewDecoder(body)
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+	return decoder
+}
+
+// ValidationRules are the deployment-specific limits applied on top of the
+// fixed checks. Lengths can only be tightened below the column sizes.
+type ValidationRules struct {
+	MinPrice             float32
+	MaxNameLength        int
+	MaxDescriptionLength int
+	RequireDescription   bool
+}
+
+var DefaultValidationRules = ValidationRules{
+	MaxNameLength:        MaxProductNameLength,
+	MaxDescriptionLength: MaxProductDescriptionLength,
+}
+
+func (rules ValidationRules) normalize() ValidationRules {
+	if rules.MaxNameLength <= 0 || rules.MaxNameLength > MaxProductNameLength {
+		rules.MaxNameLength = MaxProductNameLength
+	}
+	if rules.MaxDescriptionLength <= 0 || rules.MaxDescriptionLength > MaxProductDescriptionLength {
+		rules.MaxDescriptionLength = MaxProductDescriptionLength
+	}
+	return rules
+}
+
+func (request *CreateProductRequest) Validate() error {
+	return request.ValidateWith(DefaultValidationRules)
+}
+
+func (request *CreateProductRequest) ValidateWith(rules ValidationRules) error {
+	rules = rules.normalize()
+	validationError := &ValidationError{}
+	if request.Name == "" {
+		validationError.Add("name", "required", "is required")
+	}
+	if len(request.Name) > rules.MaxNameLength {
+		validationError.Add("name", "max", fmt.Sprintf("must be at most %d characters", rules.MaxNameLength))
+	}
+	if request.Price <= 0 {
+		validationError.Add("price", "gt", "must be greater than 0")
+	} else if request.Price < rules.MinPrice {
+		validationError.Add("price", "gte", fmt.Sprintf("must be at least %g", rules.MinPrice))
+	}
+	if rules.RequireDescription && request.Description == "" {
+		validationError.Add("description", "required", "is required")
+	}
+	if len(request.Description) > rules.MaxDescriptionLength {
+		validationError.Add("description", "max", fmt.Sprintf("must be at most %d characters", rules.MaxDescriptionLength))
+	}
+	if request.Discount != nil {
+		request.Discount.validate("discount.", validationError)
+	}
+	return validationError.OrNil()
+}
+
+func (request *DiscountRequest) validate(prefix string, validationError *ValidationError) {
+	switch request.Type {
+	case "percentage":
+		if request.Value <= 0 {
+			validationError.Add(prefix+"value", "gt", "must be greater than 0")
+		}
+		if request.Value > 100 {
+			validationError.Add(prefix+"value", "lte", "must be at most 100 for percentage discounts")
+		}
+	case "fixed":
+		if request.Value <= 0 {
+			validationError.Add(prefix+"value", "gt", "must be greater than 0")
+		}
+	default:
+		validationError.Add(prefix+"type", "oneof", "must be percentage or fixed")
+	}
+	if request.StartsAt != nil && request.EndsAt != nil && !request.EndsAt.After(*request.StartsAt) {
+		validationError.Add(prefix+"endsAt", "gtfield", "must be after "+prefix+"startsAt")
+	}
+}
@@ -0,0 +1,6 @@
+package dto
+
+type ProductIDsResponse struct {
+	IDs   []int32 `json:"ids"`
+	Total int     `json:"total"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+type DiscountResponse struct {
+	Type     string     `json:"type"`
+	Value    float32    `json:"value"`
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+	EndsAt   *time.Time `json:"endsAt,omitempty"`
+}
+
+type ProductResponse struct {
+	ID             int32             `json:"id"`
+	Name           string            `json:"name"`
+	Price          Price             `json:"price"`
+	Description    string            `json:"description"`
+	Discount       *DiscountResponse `json:"discount,omitempty"`
+	EffectivePrice *Price            `json:"effectivePrice,omitempty"`
+	UpdatedAt      *time.Time        `json:"updatedAt,omitempty"`
+	Deleted        bool              `json:"deleted,omitempty"`
+}
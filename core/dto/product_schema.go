@@ -0,0 +1,50 @@
+package dto
+
+// CreateProductRequestSchema describes CreateProductRequest as a JSON Schema
+// (draft 2020-12) with the same constraints ValidateWith enforces for rules.
+// Keep the two in step when either changes.
+func CreateProductRequestSchema(rules ValidationRules) map[string]any {
+	rules = rules.normalize()
+
+	price := map[string]any{"type": "number", "exclusiveMinimum": 0}
+	if rules.MinPrice > 0 {
+		price["minimum"] = rules.MinPrice
+	}
+	description := map[string]any{"type": "string", "maxLength": rules.MaxDescriptionLength}
+	required := []string{"name", "price"}
+	if rules.RequireDescription {
+		description["minLength"] = 1
+		required = append(required, "description")
+	}
+
+	return map[string]any{
+		"$schema":  "https://json-schema.org/draft/2020-12/schema",
+		"title":    "CreateProductRequest",
+		"type":     "object",
+		"required": required,
+		"properties": map[string]any{
+			"name":        map[string]any{"type": "string", "minLength": 1, "maxLength": rules.MaxNameLength},
+			"price":       price,
+			"description": description,
+			"discount": map[string]any{
+				"type":     []string{"object", "null"},
+				"required": []string{"type", "value"},
+				"properties": map[string]any{
+					"type":     map[string]any{"enum": []string{"percentage", "fixed"}},
+					"value":    map[string]any{"type": "number", "exclusiveMinimum": 0},
+					"startsAt": map[string]any{"type": []string{"string", "null"}, "format": "date-time"},
+					"endsAt":   map[string]any{"type": []string{"string", "null"}, "format": "date-time"},
+				},
+				// Percentages cannot exceed 100; endsAt must follow startsAt,
+				// which JSON Schema cannot express and is only checked on
+				// submission.
+				"if": map[string]any{
+					"properties": map[string]any{"type": map[string]any{"const": "percentage"}},
+				},
+				"then": map[string]any{
+					"properties": map[string]any{"value": map[string]any{"maximum": 100}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateWith(t *testing.T) {
+	chair := CreateProductRequest{Name: "Oak chair", Price: 10}
+	tests := []struct {
+		name      string
+		rules     ValidationRules
+		request   CreateProductRequest
+		wantRules []string
+	}{
+		{name: "defaults", rules: DefaultValidationRules, request: chair},
+		{name: "price above the minimum", rules: ValidationRules{MinPrice: 5}, request: chair},
+		{name: "price below the minimum", rules: ValidationRules{MinPrice: 20}, request: chair, wantRules: []string{"price:gte"}},
+		{name: "name within a tightened length", rules: ValidationRules{MaxNameLength: 9}, request: chair},
+		{name: "name over a tightened length", rules: ValidationRules{MaxNameLength: 8}, request: chair, wantRules: []string{"name:max"}},
+		{
+			name:      "length above the column size",
+			rules:     ValidationRules{MaxNameLength: MaxProductNameLength + 10},
+			request:   CreateProductRequest{Name: strings.Repeat("a", MaxProductNameLength+1), Price: 10},
+			wantRules: []string{"name:max"},
+		},
+		{name: "description required", rules: ValidationRules{RequireDescription: true}, request: chair, wantRules: []string{"description:required"}},
+		{
+			name:      "description over a tightened length",
+			rules:     ValidationRules{MaxDescriptionLength: 3},
+			request:   CreateProductRequest{Name: "Oak chair", Price: 10, Description: "Solid oak"},
+			wantRules: []string{"description:max"},
+		},
+		{
+			name:      "every rule broken",
+			rules:     ValidationRules{MinPrice: 20, MaxNameLength: 3, RequireDescription: true},
+			request:   chair,
+			wantRules: []string{"name:max", "price:gte", "description:required"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.request.ValidateWith(test.rules)
+			if test.wantRules == nil {
+				if err != nil {
+					t.Fatalf("ValidateWith() error = %v, want nil", err)
+				}
+				return
+			}
+			var validationError *ValidationError
+			if !errors.As(err, &validationError) {
+				t.Fatalf("ValidateWith() error = %v, want a *ValidationError", err)
+			}
+			rules := []string{}
+			for _, fieldError := range validationError.Errors {
+				rules = append(rules, fieldError.Field+":"+fieldError.Rule)
+			}
+			if !reflect.DeepEqual(rules, test.wantRules) {
+				t.Errorf("failed rules = %v, want %v", rules, test.wantRules)
+			}
+		})
+	}
+}
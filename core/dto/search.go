@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+type ProductSearchRequest struct {
+	NameContains string      `json:"nameContains"`
+	MinPrice     *float32    `json:"minPrice"`
+	MaxPrice     *float32    `json:"maxPrice"`
+	Filter       *FilterNode `json:"filter"`
+	Sort         []string    `json:"sort"`
+	Descending   []bool      `json:"descending"`
+	Page         int         `json:"page"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	// Direction is the asc/desc form of Descending; send one or the other.
+	Direction []SortDirection `json:"direction"`
+}
+
+func FromJSONProductSearchRequest(body io.Reader, strict bool, limits JSONLimits) (*ProductSearchRequest, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if err := limits.Check(data); err != nil {
+		return nil, err
+	}
+
+	productSearchRequest := ProductSearchRequest{}
+	if err := newDecoder(bytes.NewReader(data), strict).Decode(&productSearchRequest); err != nil {
+		return nil, err
+	}
+	return &productSearchRequest, nil
+}
+
+func (request *ProductSearchRequest) Validate() error {
+	validationError := &ValidationError{}
+	if request.MinPrice != nil && *request.MinPrice < 0 {
+		validationError.Add("minPrice", "gte", "must be greater than or equal to 0")
+	}
+	if request.MinPrice != nil && request.MaxPrice != nil && *request.MaxPrice < *request.MinPrice {
+		validationError.Add("maxPrice", "gtefield", "must be greater than or equal to minPrice")
+	}
+	for i, field := range request.Sort {
+		if !containsString(SortableProductFields, field) {
+			validationError.Add(fmt.Sprintf("sort[%d]", i), "oneof", "must be one of the sortable product fields")
+		}
+	}
+	if request.Filter != nil {
+		nodes := 0
+		request.Filter.validate("filter", 1, &nodes, validationError)
+	}
+	if len(request.Direction) > 0 && len(request.Descending) > 0 {
+		validationError.Add("direction", "exclusive", "must not be combined with descending")
+	}
+	for i, direction := range request.Direction {
+		if _, err := ParseSortDirection(string(direction)); err != nil {
+			validationError.Add(fmt.Sprintf("direction[%d]", i), "oneof", "must be asc or desc")
+		}
+	}
+	if len(request.Direction) > len(request.Sort) {
+		validationError.Add("direction", "maxfield", "must not have more entries than sort")
+	}
+	if len(request.Descending) > len(request.Sort) {
+		validationError.Add("descending", "maxfield", "must not have more entries than sort")
+	}
+	if request.Page < 0 {
+		validationError.Add("page", "gte", "must be greater than or equal to 1")
+	}
+	if request.ItemsPerPage < 0 || request.ItemsPerPage > MaxItemsPerPage {
+		validationError.Add("itemsPerPage", "between", fmt.Sprintf("must be between 1 and %d", MaxItemsPerPage))
+	}
+	return validationError.OrNil()
+}
+
+func (request *ProductSearchRequest) Normalize() {
+	if len(request.Direction) > 0 {
+		request.Descending = make([]bool, 0, len(request.Direction))
+		for _, direction := range request.Direction {
+			parsed, _ := ParseSortDirection(string(direction))
+			request.Descending = append(request.Descending, parsed.IsDescending())
+		}
+		request.Direction = nil
+	}
+	if request.Page < 1 {
+		request.Page = 1
+	}
+	if request.ItemsPerPage < 1 {
+		request.ItemsPerPage = DefaultItemsPerPage
+	}
+}
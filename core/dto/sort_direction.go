@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+)
+
+type SortDirection string
+
+const (
+	SortAscending  SortDirection = "asc"
+	SortDescending SortDirection = "desc"
+)
+
+// ParseSortDirection accepts asc or desc in any case. An empty value is
+// ascending, matching an omitted descending flag.
+func ParseSortDirection(value string) (SortDirection, error) {
+	switch SortDirection(strings.ToLower(strings.TrimSpace(value))) {
+	case SortAscending, "":
+		return SortAscending, nil
+	case SortDescending:
+		return SortDescending, nil
+	default:
+		return "", fmt.Errorf("invalid direction value %q: expected asc or desc", value)
+	}
+}
+
+func (direction SortDirection) IsDescending() bool {
+	return direction == SortDescending
+}
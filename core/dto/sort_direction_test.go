@@ -0,0 +1,32 @@
+package dto
+
+import "testing"
+
+func TestParseSortDirection(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    SortDirection
+		wantErr bool
+	}{
+		{value: "asc", want: SortAscending},
+		{value: "desc", want: SortDescending},
+		{value: " DESC ", want: SortDescending},
+		{value: "", want: SortAscending},
+		{value: "down", wantErr: true},
+		{value: "true", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			direction, err := ParseSortDirection(test.value)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseSortDirection(%q) error = %v, want error = %v", test.value, err, test.wantErr)
+			}
+			if direction != test.want {
+				t.Errorf("ParseSortDirection(%q) = %q, want %q", test.value, direction, test.want)
+			}
+			if direction.IsDescending() != (test.want == SortDescending) {
+				t.Errorf("%q.IsDescending() = %v", direction, direction.IsDescending())
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "strings"
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+func (validationError *ValidationError) Error() string {
+	messages := make([]string, 0, len(validationError.Errors))
+	for _, fieldError := range validationError.Errors {
+		messages = append(messages, fieldError.Field+" "+fieldError.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (validationError *ValidationError) Add(field string, rule string, message string) {
+	validationError.Errors = append(validationError.Errors, FieldError{
+		Field:   field,
+		Rule:    rule,
+		Message: message,
+	})
+}
+
+func (validationError *ValidationError) Merge(prefix string, other *ValidationError) {
+	for _, fieldError := range other.Errors {
+		fieldError.Field = prefix + fieldError.Field
+		validationError.Errors = append(validationError.Errors, fieldError)
+	}
+}
+
+func (validationError *ValidationError) OrNil() error {
+	if len(validationError.Errors) == 0 {
+		return nil
+	}
+	return validationError
+}
@@ -0,0 +1,25 @@
+package mapper
+
+import (
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func ToBatchResultResponses(results []domain.BatchResult) []dto.BatchResultResponse {
+	responses := make([]dto.BatchResultResponse, 0, len(results))
+	for _, result := range results {
+		response := dto.BatchResultResponse{
+			Index:  result.Index,
+			Op:     result.Op,
+			ID:     result.ID,
+			Status: result.Status,
+			Error:  result.Error,
+		}
+		if result.Product != nil {
+			productResponse := ToProductResponse(result.Product)
+			response.Product = &productResponse
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
@@ -0,0 +1,112 @@
+package mapper
+
+import (
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func ToProduct(request *dto.CreateProductRequest) *domain.Product {
+	product := domain.Product{
+		Name:        request.Name,
+		Price:       request.Price,
+		Description: request.Description,
+	}
+	if discount := request.Discount; discount != nil {
+		product.Discount = &domain.Discount{
+			Type:     discount.Type,
+			Value:    discount.Value,
+			StartsAt: discount.StartsAt,
+			EndsAt:   discount.EndsAt,
+		}
+	}
+	return &product
+}
+
+func ToProductResponse(product *domain.Product) dto.ProductResponse {
+	response := dto.ProductResponse{
+		ID:          product.ID,
+		Name:        product.Name,
+		Price:       dto.Price(product.Price),
+		Description: product.Description,
+		Deleted:     product.Deleted,
+	}
+	if product.EffectivePrice != nil {
+		effectivePrice := dto.Price(*product.EffectivePrice)
+		response.EffectivePrice = &effectivePrice
+	}
+	if !product.UpdatedAt.IsZero() {
+		updatedAt := product.UpdatedAt
+		response.UpdatedAt = &updatedAt
+	}
+	if discount := product.Discount; discount != nil {
+		response.Discount = &dto.DiscountResponse{
+			Type:     discount.Type,
+			Value:    discount.Value,
+			StartsAt: discount.StartsAt,
+			EndsAt:   discount.EndsAt,
+		}
+	}
+	return response
+}
+
+func ToProductResponses(products []domain.Product) []dto.ProductResponse {
+	responses := make([]dto.ProductResponse, 0, len(products))
+	for i := range products {
+		responses = append(responses, ToProductResponse(&products[i]))
+	}
+	return responses
+}
+
+func ToProductPaginationResponse(products *domain.Pagination[[]domain.Product]) dto.PaginationResponse[[]dto.ProductResponse] {
+	return dto.PaginationResponse[[]dto.ProductResponse]{
+		Items:         ToProductResponses(products.Items),
+		Total:         products.Total,
+		CountStrategy: products.CountStrategy,
+		TotalCapped:   products.TotalCapped,
+		OutOfRange:    products.OutOfRange,
+	}
+}
+
+func FromProductResponse(response *dto.ProductResponse) *domain.Product {
+	product := domain.Product{
+		ID:          response.ID,
+		Name:        response.Name,
+		Price:       float32(response.Price),
+		Description: response.Description,
+		Deleted:     response.Deleted,
+	}
+	if response.EffectivePrice != nil {
+		effectivePrice := float32(*response.EffectivePrice)
+		product.EffectivePrice = &effectivePrice
+	}
+	if response.UpdatedAt != nil {
+		product.UpdatedAt = *response.UpdatedAt
+	}
+	if discount := response.Discount; discount != nil {
+		product.Discount = &domain.Discount{
+			Type:     discount.Type,
+			Value:    discount.Value,
+			StartsAt: discount.StartsAt,
+			EndsAt:   discount.EndsAt,
+		}
+	}
+	return &product
+}
+
+func FromProductResponses(responses []dto.ProductResponse) []domain.Product {
+	products := make([]domain.Product, 0, len(responses))
+	for i := range responses {
+		products = append(products, *FromProductResponse(&responses[i]))
+	}
+	return products
+}
+
+func FromProductPaginationResponse(response *dto.PaginationResponse[[]dto.ProductResponse]) *domain.Pagination[[]domain.Product] {
+	return &domain.Pagination[[]domain.Product]{
+		Items:         FromProductResponses(response.Items),
+		Total:         response.Total,
+		CountStrategy: response.CountStrategy,
+		TotalCapped:   response.TotalCapped,
+		OutOfRange:    response.OutOfRange,
+	}
+}
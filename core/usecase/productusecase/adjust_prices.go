@@ -0,0 +1,17 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) AdjustPrices(ctx context.Context, adjustPriceRequest *dto.AdjustPriceRequest) (int64, error) {
+	if err := adjustPriceRequest.Validate(); err != nil {
+		return 0, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+
+	return usecase.repository.AdjustPrices(ctx, adjustPriceRequest)
+}
@@ -0,0 +1,10 @@
+package productusecase
+
+import (
+	"context"
+	"time"
+)
+
+func (usecase usecase) ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int64, error) {
+	return usecase.repository.ApplyDuePriceSchedules(ctx, now)
+}
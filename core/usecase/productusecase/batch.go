@@ -0,0 +1,67 @@
+package productusecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) Batch(ctx context.Context, batchRequest *dto.BatchRequest) ([]domain.BatchResult, error) {
+	if err := usecase.validateBatch(batchRequest); err != nil {
+		return nil, err
+	}
+
+	results, err := usecase.repository.Batch(ctx, batchRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range results {
+		if results[i].Product != nil {
+			usecase.applyEffectivePrice(ctx, results[i].Product, now)
+		}
+	}
+	return results, nil
+}
+
+func (usecase usecase) validateBatch(batchRequest *dto.BatchRequest) error {
+	validationError := &dto.ValidationError{}
+	if len(batchRequest.Operations) == 0 {
+		validationError.Add("operations", "min", "at least one operation is required")
+	}
+	if len(batchRequest.Operations) > usecase.options.MaxBatchSize {
+		return fmt.Errorf("%w: at most %d operations can be sent at once", domain.ErrValidation, usecase.options.MaxBatchSize)
+	}
+	for i, operation := range batchRequest.Operations {
+		prefix := fmt.Sprintf("operations[%d]", i)
+		switch operation.Op {
+		case dto.BatchOpCreate, dto.BatchOpUpdate, dto.BatchOpDelete:
+		default:
+			validationError.Add(prefix+".op", "oneof", "must be one of create, update or delete")
+			continue
+		}
+		if operation.Op != dto.BatchOpCreate && operation.ID < 1 {
+			validationError.Add(prefix+".id", "gte", "must be greater than 0")
+		}
+		if operation.Op == dto.BatchOpDelete {
+			continue
+		}
+		if operation.Product == nil {
+			validationError.Add(prefix+".product", "required", "is required")
+			continue
+		}
+		var itemError *dto.ValidationError
+		if err := operation.Product.ValidateWith(usecase.options.Validation); errors.As(err, &itemError) {
+			validationError.Merge(prefix+".product.", itemError)
+		}
+	}
+	if err := validationError.OrNil(); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+	return nil
+}
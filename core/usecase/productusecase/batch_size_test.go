@@ -0,0 +1,80 @@
+package productusecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// bulkRepository counts the bulk calls that reach it and accepts them all.
+type bulkRepository struct {
+	domain.ProductRepository
+	calls int
+}
+
+func (repository *bulkRepository) CreateManyCopy(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error) {
+	repository.calls++
+	return int64(len(productRequests)), nil
+}
+
+func (repository *bulkRepository) DeleteMany(ctx context.Context, ids []int32) ([]domain.DeleteResult, error) {
+	repository.calls++
+	return nil, nil
+}
+
+func (repository *bulkRepository) Batch(ctx context.Context, batchRequest *dto.BatchRequest) ([]domain.BatchResult, error) {
+	repository.calls++
+	return nil, nil
+}
+
+func TestMaxBatchSize(t *testing.T) {
+	const maxBatchSize = 3
+	bulkCalls := []struct {
+		name string
+		call func(usecase domain.ProductUseCase, items int) error
+	}{
+		{"create many", func(usecase domain.ProductUseCase, items int) error {
+			productRequests := make([]*dto.CreateProductRequest, items)
+			for i := range productRequests {
+				productRequests[i] = &dto.CreateProductRequest{Name: "Chair", Price: 10}
+			}
+			_, err := usecase.CreateMany(context.Background(), productRequests)
+			return err
+		}},
+		{"delete many", func(usecase domain.ProductUseCase, items int) error {
+			ids := make([]int32, items)
+			for i := range ids {
+				ids[i] = int32(i + 1)
+			}
+			_, err := usecase.DeleteMany(context.Background(), ids)
+			return err
+		}},
+		{"batch", func(usecase domain.ProductUseCase, items int) error {
+			operations := make([]dto.BatchOperation, items)
+			for i := range operations {
+				operations[i] = dto.BatchOperation{Op: dto.BatchOpDelete, ID: int32(i + 1)}
+			}
+			_, err := usecase.Batch(context.Background(), &dto.BatchRequest{Operations: operations})
+			return err
+		}},
+	}
+	for _, bulkCall := range bulkCalls {
+		t.Run(bulkCall.name, func(t *testing.T) {
+			repository := &bulkRepository{}
+			usecase := New(repository, Options{MaxBatchSize: maxBatchSize})
+
+			if err := bulkCall.call(usecase, maxBatchSize); err != nil {
+				t.Errorf("%d items: error = %v, want none", maxBatchSize, err)
+			}
+			if err := bulkCall.call(usecase, maxBatchSize+1); !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("%d items: error = %v, want %v", maxBatchSize+1, err, domain.ErrValidation)
+			}
+			if repository.calls != 1 {
+				t.Errorf("repository calls = %d, want 1: the oversized request must not reach it", repository.calls)
+			}
+		})
+	}
+}
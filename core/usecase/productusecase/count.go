@@ -0,0 +1,19 @@
+package productusecase
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) Count(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	if paginationRequest == nil {
+		paginationRequest = dto.DefaultPaginationRequestParams()
+	}
+	if err := usecase.checkPagination(paginationRequest); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.Count(ctx, paginationRequest)
+}
@@ -1,15 +1,24 @@
 package productusecase
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/gabriwl165/clean-arch-go/core/domain"
 	"github.com/gabriwl165/clean-arch-go/core/dto"
 )
 
-func (usecase usecase) Create(productRequest *dto.CreateProductRequest) (*domain.Product, error) {
-	product, err := usecase.repository.Create(productRequest)
+func (usecase usecase) Create(ctx context.Context, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	if err := productRequest.ValidateWith(usecase.options.Validation); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+
+	product, err := usecase.repository.Create(ctx, productRequest)
 	if err != nil {
 		return nil, err
 	}
 
+	usecase.applyEffectivePrice(ctx, product, time.Now())
 	return product, err
 }
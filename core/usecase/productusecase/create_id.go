@@ -0,0 +1,17 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) CreateID(ctx context.Context, productRequest *dto.CreateProductRequest) (int32, error) {
+	if err := productRequest.ValidateWith(usecase.options.Validation); err != nil {
+		return 0, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+
+	return usecase.repository.CreateID(ctx, productRequest)
+}
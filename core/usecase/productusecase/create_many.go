@@ -0,0 +1,43 @@
+package productusecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) CreateMany(ctx context.Context, productRequests []*dto.CreateProductRequest) (int64, error) {
+	if err := usecase.validateMany(productRequests); err != nil {
+		return 0, err
+	}
+
+	return usecase.repository.CreateManyCopy(ctx, productRequests)
+}
+
+func (usecase usecase) validateMany(productRequests []*dto.CreateProductRequest) error {
+	if len(productRequests) > usecase.options.MaxBatchSize {
+		return fmt.Errorf("%w: at most %d products can be created at once", domain.ErrValidation, usecase.options.MaxBatchSize)
+	}
+	validationError := &dto.ValidationError{}
+	if len(productRequests) == 0 {
+		validationError.Add("body", "min", "at least one product is required")
+	}
+	for i, productRequest := range productRequests {
+		prefix := fmt.Sprintf("[%d].", i)
+		if productRequest == nil {
+			validationError.Add(fmt.Sprintf("[%d]", i), "required", "is required")
+			continue
+		}
+		var itemError *dto.ValidationError
+		if err := productRequest.ValidateWith(usecase.options.Validation); errors.As(err, &itemError) {
+			validationError.Merge(prefix, itemError)
+		}
+	}
+	if err := validationError.OrNil(); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+	return nil
+}
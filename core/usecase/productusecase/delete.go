@@ -0,0 +1,7 @@
+package productusecase
+
+import "context"
+
+func (usecase usecase) Delete(ctx context.Context, id int32) error {
+	return usecase.repository.Delete(ctx, id)
+}
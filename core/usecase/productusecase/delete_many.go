@@ -0,0 +1,19 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (usecase usecase) DeleteMany(ctx context.Context, ids []int32) ([]domain.DeleteResult, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: ids is required", domain.ErrValidation)
+	}
+	if len(ids) > usecase.options.MaxBatchSize {
+		return nil, fmt.Errorf("%w: at most %d ids can be deleted at once", domain.ErrValidation, usecase.options.MaxBatchSize)
+	}
+
+	return usecase.repository.DeleteMany(ctx, ids)
+}
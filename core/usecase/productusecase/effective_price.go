@@ -0,0 +1,27 @@
+package productusecase
+
+import (
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (usecase usecase) EffectivePrice(product *domain.Product, now time.Time) float32 {
+	discount := product.Discount
+	if discount == nil || !discount.ActiveAt(now) {
+		return product.Price
+	}
+
+	price := product.Price
+	switch discount.Type {
+	case domain.DiscountTypePercentage:
+		price = product.Price * (1 - discount.Value/100)
+	case domain.DiscountTypeFixed:
+		price = product.Price - discount.Value
+	}
+
+	if price < 0 {
+		return 0
+	}
+	return price
+}
@@ -0,0 +1,77 @@
+package productusecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestEffectivePriceOnlyWhenRequested(t *testing.T) {
+	products := []domain.Product{
+		{ID: 1, Price: 100, Discount: &domain.Discount{Type: domain.DiscountTypePercentage, Value: 25}},
+		{ID: 2, Price: 40},
+	}
+	calls := []struct {
+		name string
+		run  func(domain.ProductUseCase, context.Context) ([]domain.Product, error)
+	}{
+		{name: "get by id", run: func(usecase domain.ProductUseCase, ctx context.Context) ([]domain.Product, error) {
+			product, err := usecase.GetByID(ctx, 1)
+			if err != nil {
+				return nil, err
+			}
+			return []domain.Product{*product}, nil
+		}},
+		{name: "get by ids", run: func(usecase domain.ProductUseCase, ctx context.Context) ([]domain.Product, error) {
+			return usecase.GetByIDs(ctx, []int32{1, 2})
+		}},
+		{name: "search", run: func(usecase domain.ProductUseCase, ctx context.Context) ([]domain.Product, error) {
+			result, err := usecase.Search(ctx, &dto.ProductSearchRequest{})
+			if err != nil {
+				return nil, err
+			}
+			return result.Items, nil
+		}},
+		{name: "preview", run: func(usecase domain.ProductUseCase, ctx context.Context) ([]domain.Product, error) {
+			product, err := usecase.Preview(ctx, &dto.CreateProductRequest{Name: "Lamp", Price: 100, Discount: &dto.DiscountRequest{Type: domain.DiscountTypePercentage, Value: 25}})
+			if err != nil {
+				return nil, err
+			}
+			return []domain.Product{*product}, nil
+		}},
+	}
+	for _, call := range calls {
+		for _, withPricing := range []bool{false, true} {
+			name := call.name
+			if withPricing {
+				name += " with pricing"
+			}
+			t.Run(name, func(t *testing.T) {
+				usecase := New(&fakeRepository{products: products}, Options{})
+				ctx := dto.ContextWithPricing(context.Background(), withPricing)
+
+				got, err := call.run(usecase, ctx)
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				for _, product := range got {
+					if !withPricing {
+						if product.EffectivePrice != nil {
+							t.Errorf("product %d effective price = %v, want none", product.ID, *product.EffectivePrice)
+						}
+						continue
+					}
+					want := product.Price
+					if product.Discount != nil {
+						want = 75
+					}
+					if product.EffectivePrice == nil || *product.EffectivePrice != want {
+						t.Errorf("product %d effective price = %v, want %v", product.ID, product.EffectivePrice, want)
+					}
+				}
+			})
+		}
+	}
+}
@@ -1,14 +1,43 @@
 package productusecase
 
 import (
+	"context"
+	"time"
+
 	"github.com/gabriwl165/clean-arch-go/core/domain"
 	"github.com/gabriwl165/clean-arch-go/core/dto"
 )
 
-func (usecase usecase) Fetch(paginationRequest *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
-	products, err := usecase.repository.Fetch(paginationRequest)
+func (usecase usecase) Fetch(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	if paginationRequest == nil {
+		paginationRequest = dto.DefaultPaginationRequestParams()
+	}
+	if err := usecase.checkPagination(paginationRequest); err != nil {
+		return nil, err
+	}
+
+	products, err := usecase.repository.Fetch(ctx, paginationRequest)
 	if err != nil {
 		return nil, err
 	}
+
+	products.MarkOutOfRange(paginationRequest.Page, paginationRequest.ItemsPerPage, len(products.Items))
+
+	ctx = dto.ContextWithPricing(ctx, paginationRequest.WithPricing)
+	now := time.Now()
+	for i := range products.Items {
+		usecase.applyEffectivePrice(ctx, &products.Items[i], now)
+	}
+
 	return products, nil
 }
+
+// applyEffectivePrice sets the effective price of product only when the
+// request asked for it, as recorded by dto.ContextWithPricing.
+func (usecase usecase) applyEffectivePrice(ctx context.Context, product *domain.Product, now time.Time) {
+	if !dto.PricingRequested(ctx) {
+		return
+	}
+	effectivePrice := usecase.EffectivePrice(product, now)
+	product.EffectivePrice = &effectivePrice
+}
@@ -0,0 +1,11 @@
+package productusecase
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (usecase usecase) FetchFacets(ctx context.Context, search string) (*domain.ProductFacets, error) {
+	return usecase.repository.FetchFacets(ctx, search)
+}
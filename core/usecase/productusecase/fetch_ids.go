@@ -0,0 +1,18 @@
+package productusecase
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) FetchIDs(ctx context.Context, paginationRequest *dto.PaginationRequestParams) ([]int32, error) {
+	if paginationRequest == nil {
+		paginationRequest = dto.DefaultPaginationRequestParams()
+	}
+	if err := usecase.checkPagination(paginationRequest); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.FetchIDs(ctx, paginationRequest)
+}
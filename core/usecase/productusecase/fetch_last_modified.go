@@ -0,0 +1,19 @@
+package productusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) FetchLastModified(ctx context.Context, paginationRequest *dto.PaginationRequestParams) (*time.Time, error) {
+	if paginationRequest == nil {
+		paginationRequest = dto.DefaultPaginationRequestParams()
+	}
+	if err := usecase.checkPagination(paginationRequest); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.FetchLastModified(ctx, paginationRequest)
+}
@@ -0,0 +1,104 @@
+package productusecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestFetchValidatesSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		sort      []string
+		wantValid bool
+	}{
+		{name: "default", wantValid: true},
+		{name: "sortable", sort: []string{"price", "name"}, wantValid: true},
+		{name: "misspelled", sort: []string{"pice"}},
+		{name: "expression", sort: []string{"price; DROP TABLE product"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fetched := false
+			usecase := New(&fakeRepository{fetch: func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+				fetched = true
+				return &domain.Pagination[[]domain.Product]{Items: []domain.Product{}}, nil
+			}}, Options{})
+			pagination := &dto.PaginationRequestParams{Sort: test.sort}
+			pagination.Normalize()
+
+			_, err := usecase.Fetch(context.Background(), pagination)
+
+			if test.wantValid && err != nil {
+				t.Fatalf("Fetch() error = %v", err)
+			}
+			if !test.wantValid && !errors.Is(err, domain.ErrValidation) {
+				t.Fatalf("Fetch() error = %v, want a validation error", err)
+			}
+			if fetched != test.wantValid {
+				t.Errorf("repository called = %v, want %v", fetched, test.wantValid)
+			}
+		})
+	}
+}
+
+func TestFetchWithNilPagination(t *testing.T) {
+	var fetched *dto.PaginationRequestParams
+	usecase := New(&fakeRepository{fetch: func(pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+		fetched = pagination
+		return &domain.Pagination[[]domain.Product]{Items: []domain.Product{}}, nil
+	}}, Options{})
+
+	if _, err := usecase.Fetch(context.Background(), nil); err != nil {
+		t.Fatalf("Fetch(nil) error = %v", err)
+	}
+	if fetched == nil || fetched.Page != 1 || fetched.ItemsPerPage != dto.DefaultItemsPerPage {
+		t.Errorf("repository fetched %+v, want page 1 of %d items", fetched, dto.DefaultItemsPerPage)
+	}
+}
+
+func TestFetchMarksPagesOutOfRange(t *testing.T) {
+	const total = 25
+	usecase := New(&fakeRepository{fetch: func(pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+		items := []domain.Product{}
+		for i := (pagination.Page - 1) * pagination.ItemsPerPage; i < min(pagination.Page*pagination.ItemsPerPage, total); i++ {
+			items = append(items, domain.Product{ID: int32(i + 1)})
+		}
+		return &domain.Pagination[[]domain.Product]{Items: items, Total: total}, nil
+	}}, Options{})
+
+	tests := []struct {
+		name           string
+		page           int
+		wantItems      int
+		wantOutOfRange bool
+	}{
+		{name: "last page", page: 3, wantItems: 5},
+		{name: "past the last page", page: 4, wantOutOfRange: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			products, err := usecase.Fetch(context.Background(), &dto.PaginationRequestParams{Page: test.page, ItemsPerPage: 10})
+			if err != nil {
+				t.Fatalf("Fetch() error = %v", err)
+			}
+			if len(products.Items) != test.wantItems || products.OutOfRange != test.wantOutOfRange {
+				t.Errorf("page %d = %d items, outOfRange %v, want %d items, outOfRange %v", test.page, len(products.Items), products.OutOfRange, test.wantItems, test.wantOutOfRange)
+			}
+		})
+	}
+
+	empty := New(&fakeRepository{fetch: func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+		return &domain.Pagination[[]domain.Product]{Items: []domain.Product{}}, nil
+	}}, Options{})
+	products, err := empty.Fetch(context.Background(), &dto.PaginationRequestParams{Page: 1, ItemsPerPage: 10})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if products.OutOfRange {
+		t.Error("an empty result set is marked out of range")
+	}
+}
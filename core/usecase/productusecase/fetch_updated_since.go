@@ -0,0 +1,30 @@
+package productusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) FetchUpdatedSince(ctx context.Context, since time.Time, paginationRequest *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	if paginationRequest == nil {
+		paginationRequest = dto.DefaultPaginationRequestParams()
+	}
+
+	products, err := usecase.repository.FetchUpdatedSince(ctx, since, paginationRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = dto.ContextWithPricing(ctx, paginationRequest.WithPricing)
+	now := time.Now()
+	for i := range products.Items {
+		if !products.Items[i].Deleted {
+			usecase.applyEffectivePrice(ctx, &products.Items[i], now)
+		}
+	}
+
+	return products, nil
+}
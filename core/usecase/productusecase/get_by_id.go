@@ -0,0 +1,18 @@
+package productusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (usecase usecase) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	product, err := usecase.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.applyEffectivePrice(ctx, product, time.Now())
+	return product, nil
+}
@@ -0,0 +1,30 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) GetByIDs(ctx context.Context, ids []int32) ([]domain.Product, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: ids is required", domain.ErrValidation)
+	}
+	if len(ids) > dto.MaxItemsPerPage {
+		return nil, fmt.Errorf("%w: at most %d ids can be fetched at once", domain.ErrValidation, dto.MaxItemsPerPage)
+	}
+
+	products, err := usecase.repository.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range products {
+		usecase.applyEffectivePrice(ctx, &products[i], now)
+	}
+	return products, nil
+}
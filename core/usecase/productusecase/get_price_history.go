@@ -0,0 +1,15 @@
+package productusecase
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (usecase usecase) GetPriceHistory(ctx context.Context, productID int32) ([]domain.PriceChange, error) {
+	if _, err := usecase.repository.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.GetPriceHistory(ctx, productID)
+}
@@ -0,0 +1,26 @@
+package productusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func (usecase usecase) GetRelated(ctx context.Context, id int32, limit int) ([]domain.Product, error) {
+	if _, err := usecase.repository.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	products, err := usecase.repository.GetRelated(ctx, id, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range products {
+		usecase.applyEffectivePrice(ctx, &products[i], now)
+	}
+
+	return products, nil
+}
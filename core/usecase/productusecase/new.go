@@ -1,13 +1,42 @@
 package productusecase
 
-import "github.com/gabriwl165/clean-arch-go/core/domain"
+import (
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+const (
+	SearchPolicyReject = "reject"
+	SearchPolicyIgnore = "ignore"
+)
+
+type Options struct {
+	// SearchMinLength is the shortest search term Fetch accepts; zero
+	// disables the check.
+	SearchMinLength int
+	// SearchPolicy decides what happens to shorter terms: they are rejected
+	// with a validation error or dropped from the query.
+	SearchPolicy string
+	Validation   dto.ValidationRules
+	// MaxBatchSize caps the items of every bulk request: bulk create, bulk
+	// delete and batch. Zero means dto.DefaultMaxBatchSize.
+	MaxBatchSize int
+}
 
 type usecase struct {
 	repository domain.ProductRepository
+	options    Options
 }
 
-func New(repository domain.ProductRepository) domain.ProductUseCase {
+func New(repository domain.ProductRepository, options Options) domain.ProductUseCase {
+	if options.SearchPolicy != SearchPolicyIgnore {
+		options.SearchPolicy = SearchPolicyReject
+	}
+	if options.MaxBatchSize <= 0 {
+		options.MaxBatchSize = dto.DefaultMaxBatchSize
+	}
 	return &usecase{
 		repository: repository,
+		options:    options,
 	}
 }
@@ -0,0 +1,18 @@
+package productusecase
+
+import (
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// checkPagination validates the listing parameters shared by Fetch and the
+// queries that match the same products, then applies the search length
+// policy.
+func (usecase usecase) checkPagination(paginationRequest *dto.PaginationRequestParams) error {
+	if err := paginationRequest.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+	return usecase.checkSearchLength(paginationRequest)
+}
@@ -0,0 +1,36 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+	"github.com/gabriwl165/clean-arch-go/core/mapper"
+)
+
+func (usecase usecase) Preview(ctx context.Context, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	if err := productRequest.ValidateWith(usecase.options.Validation); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+
+	product := mapper.ToProduct(productRequest)
+	usecase.applyEffectivePrice(ctx, product, time.Now())
+	return product, nil
+}
+
+func (usecase usecase) PreviewMany(ctx context.Context, productRequests []*dto.CreateProductRequest) ([]domain.Product, error) {
+	if err := usecase.validateMany(productRequests); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	products := make([]domain.Product, 0, len(productRequests))
+	for _, productRequest := range productRequests {
+		product := mapper.ToProduct(productRequest)
+		usecase.applyEffectivePrice(ctx, product, now)
+		products = append(products, *product)
+	}
+	return products, nil
+}
@@ -0,0 +1,40 @@
+package productusecase
+
+import (
+	"context"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// fakeRepository answers the calls a test sets a function for; any other
+// call panics on the nil embedded interface.
+type fakeRepository struct {
+	domain.ProductRepository
+	fetch         func(*dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error)
+	schedulePrice func(int32, *dto.SchedulePriceRequest) (*domain.PriceSchedule, error)
+	products      []domain.Product
+}
+
+func (repository *fakeRepository) Fetch(ctx context.Context, pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+	return repository.fetch(pagination)
+}
+
+func (repository *fakeRepository) SchedulePrice(ctx context.Context, productID int32, schedulePriceRequest *dto.SchedulePriceRequest) (*domain.PriceSchedule, error) {
+	return repository.schedulePrice(productID, schedulePriceRequest)
+}
+
+// The calls below answer with copies of products.
+
+func (repository *fakeRepository) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	product := repository.products[0]
+	return &product, nil
+}
+
+func (repository *fakeRepository) GetByIDs(ctx context.Context, ids []int32) ([]domain.Product, error) {
+	return append([]domain.Product{}, repository.products...), nil
+}
+
+func (repository *fakeRepository) Search(ctx context.Context, searchRequest *dto.ProductSearchRequest) (*domain.Pagination[[]domain.Product], error) {
+	return &domain.Pagination[[]domain.Product]{Items: append([]domain.Product{}, repository.products...)}, nil
+}
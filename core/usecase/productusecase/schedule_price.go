@@ -0,0 +1,22 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) SchedulePrice(ctx context.Context, productID int32, schedulePriceRequest *dto.SchedulePriceRequest) (*domain.PriceSchedule, error) {
+	if err := schedulePriceRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+
+	schedule, err := usecase.repository.SchedulePrice(ctx, productID, schedulePriceRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
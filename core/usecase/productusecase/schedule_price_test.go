@@ -0,0 +1,47 @@
+package productusecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestSchedulePriceValidates(t *testing.T) {
+	effectiveAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		request   dto.SchedulePriceRequest
+		wantValid bool
+	}{
+		{name: "valid", request: dto.SchedulePriceRequest{NewPrice: 9.5, EffectiveAt: effectiveAt}, wantValid: true},
+		{name: "zero price", request: dto.SchedulePriceRequest{EffectiveAt: effectiveAt}},
+		{name: "negative price", request: dto.SchedulePriceRequest{NewPrice: -1, EffectiveAt: effectiveAt}},
+		{name: "missing effectiveAt", request: dto.SchedulePriceRequest{NewPrice: 9.5}},
+		{name: "empty", request: dto.SchedulePriceRequest{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scheduled := false
+			usecase := New(&fakeRepository{schedulePrice: func(productID int32, request *dto.SchedulePriceRequest) (*domain.PriceSchedule, error) {
+				scheduled = true
+				return &domain.PriceSchedule{ProductID: productID, NewPrice: request.NewPrice, EffectiveAt: request.EffectiveAt}, nil
+			}}, Options{})
+
+			_, err := usecase.SchedulePrice(context.Background(), 1, &test.request)
+
+			if test.wantValid && err != nil {
+				t.Fatalf("SchedulePrice() error = %v", err)
+			}
+			if !test.wantValid && !errors.Is(err, domain.ErrValidation) {
+				t.Fatalf("SchedulePrice() error = %v, want a validation error", err)
+			}
+			if scheduled != test.wantValid {
+				t.Errorf("scheduled = %v, want %v", scheduled, test.wantValid)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func (usecase usecase) Search(ctx context.Context, searchRequest *dto.ProductSearchRequest) (*domain.Pagination[[]domain.Product], error) {
+	if err := searchRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+	searchRequest.Normalize()
+
+	products, err := usecase.repository.Search(ctx, searchRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	products.MarkOutOfRange(searchRequest.Page, searchRequest.ItemsPerPage, len(products.Items))
+
+	now := time.Now()
+	for i := range products.Items {
+		usecase.applyEffectivePrice(ctx, &products.Items[i], now)
+	}
+
+	return products, nil
+}
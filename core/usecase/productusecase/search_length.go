@@ -0,0 +1,24 @@
+package productusecase
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// checkSearchLength enforces Options.SearchMinLength on the free-text search,
+// either rejecting a short term or clearing it according to SearchPolicy.
+func (usecase usecase) checkSearchLength(paginationRequest *dto.PaginationRequestParams) error {
+	search := strings.TrimSpace(paginationRequest.Search)
+	if search == "" || usecase.options.SearchMinLength <= 0 || utf8.RuneCountInString(search) >= usecase.options.SearchMinLength {
+		return nil
+	}
+	if usecase.options.SearchPolicy == SearchPolicyIgnore {
+		paginationRequest.Search = ""
+		return nil
+	}
+	return fmt.Errorf("%w: search must be at least %d characters", domain.ErrValidation, usecase.options.SearchMinLength)
+}
@@ -0,0 +1,57 @@
+package productusecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+func TestFetchSearchMinLength(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     string
+		search     string
+		wantErr    bool
+		wantSearch string
+	}{
+		{name: "reject short term", policy: SearchPolicyReject, search: "ab", wantErr: true},
+		{name: "ignore short term", policy: SearchPolicyIgnore, search: "ab", wantSearch: ""},
+		{name: "reject long enough term", policy: SearchPolicyReject, search: "oak", wantSearch: "oak"},
+		{name: "ignore long enough term", policy: SearchPolicyIgnore, search: "oak", wantSearch: "oak"},
+		{name: "short after trimming", policy: SearchPolicyReject, search: " ab ", wantErr: true},
+		{name: "multibyte characters", policy: SearchPolicyReject, search: "äöü", wantSearch: "äöü"},
+		{name: "unknown policy rejects", policy: "drop", search: "ab", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var fetched *dto.PaginationRequestParams
+			usecase := New(&fakeRepository{fetch: func(pagination *dto.PaginationRequestParams) (*domain.Pagination[[]domain.Product], error) {
+				fetched = pagination
+				return &domain.Pagination[[]domain.Product]{Items: []domain.Product{}}, nil
+			}}, Options{SearchMinLength: 3, SearchPolicy: test.policy})
+			pagination := &dto.PaginationRequestParams{Search: test.search}
+			pagination.Normalize()
+
+			_, err := usecase.Fetch(context.Background(), pagination)
+
+			if test.wantErr {
+				if !errors.Is(err, domain.ErrValidation) {
+					t.Fatalf("Fetch() error = %v, want a validation error", err)
+				}
+				if fetched != nil {
+					t.Error("repository called for a rejected search")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fetch() error = %v", err)
+			}
+			if fetched.Search != test.wantSearch {
+				t.Errorf("fetched search = %q, want %q", fetched.Search, test.wantSearch)
+			}
+		})
+	}
+}
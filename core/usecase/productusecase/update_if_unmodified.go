@@ -0,0 +1,29 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// UpdateIfUnmodified replaces the product with id only if it was last updated
+// at updatedAt, failing with domain.ErrPreconditionFailed otherwise.
+func (usecase usecase) UpdateIfUnmodified(ctx context.Context, id int32, updatedAt time.Time, productRequest *dto.CreateProductRequest) (*domain.Product, error) {
+	if id < 1 {
+		return nil, fmt.Errorf("%w: id must be greater than 0", domain.ErrValidation)
+	}
+	if err := productRequest.ValidateWith(usecase.options.Validation); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+
+	product, err := usecase.repository.UpdateIfUnmodified(ctx, id, updatedAt, productRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.applyEffectivePrice(ctx, product, time.Now())
+	return product, nil
+}
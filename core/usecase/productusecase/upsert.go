@@ -0,0 +1,29 @@
+package productusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
+)
+
+// Upsert replaces the product with id, creating it when absent. The returned
+// bool reports whether it was created.
+func (usecase usecase) Upsert(ctx context.Context, id int32, productRequest *dto.CreateProductRequest) (*domain.Product, bool, error) {
+	if id < 1 {
+		return nil, false, fmt.Errorf("%w: id must be greater than 0", domain.ErrValidation)
+	}
+	if err := productRequest.ValidateWith(usecase.options.Validation); err != nil {
+		return nil, false, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+
+	product, created, err := usecase.repository.Upsert(ctx, id, productRequest)
+	if err != nil {
+		return nil, false, err
+	}
+
+	usecase.applyEffectivePrice(ctx, product, time.Now())
+	return product, created, nil
+}
@@ -1 +1 @@
-DROP TABLE IF EXISTS product;
+DROP TABLE IF EXISTS {{table}};
@@ -1,4 +1,4 @@
-CREATE TABLE product (
+CREATE TABLE {{table}} (
   id SERIAL PRIMARY KEY NOT NULL,
   name VARCHAR(50) NOT NULL,
   price FLOAT NOT NULL,
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS {{qualified "%s_price_schedules"}};
@@ -0,0 +1,8 @@
+CREATE TABLE {{qualified "%s_price_schedules"}} (
+  id SERIAL PRIMARY KEY NOT NULL,
+  product_id INTEGER NOT NULL REFERENCES {{table}} (id) ON DELETE CASCADE,
+  new_price FLOAT NOT NULL,
+  effective_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX {{name "%s_price_schedules_effective_at_idx"}} ON {{qualified "%s_price_schedules"}} (effective_at);
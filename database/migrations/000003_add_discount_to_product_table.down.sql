@@ -0,0 +1,5 @@
+ALTER TABLE {{table}}
+  DROP COLUMN IF EXISTS discount_type,
+  DROP COLUMN IF EXISTS discount_value,
+  DROP COLUMN IF EXISTS discount_starts_at,
+  DROP COLUMN IF EXISTS discount_ends_at;
@@ -0,0 +1,5 @@
+ALTER TABLE {{table}}
+  ADD COLUMN discount_type VARCHAR(10) CHECK (discount_type IN ('percentage', 'fixed')),
+  ADD COLUMN discount_value FLOAT,
+  ADD COLUMN discount_starts_at TIMESTAMPTZ,
+  ADD COLUMN discount_ends_at TIMESTAMPTZ;
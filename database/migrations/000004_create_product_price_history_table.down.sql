@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS {{name "%s_price_history_update"}} ON {{table}};
+DROP TRIGGER IF EXISTS {{name "%s_price_history_insert"}} ON {{table}};
+DROP FUNCTION IF EXISTS {{qualified "record_%s_price_change"}}();
+DROP TABLE IF EXISTS {{qualified "%s_price_history"}};
@@ -0,0 +1,27 @@
+CREATE TABLE {{qualified "%s_price_history"}} (
+  id SERIAL PRIMARY KEY NOT NULL,
+  product_id INTEGER NOT NULL REFERENCES {{table}} (id) ON DELETE CASCADE,
+  price FLOAT NOT NULL,
+  changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX {{name "%s_price_history_product_id_changed_at_idx"}} ON {{qualified "%s_price_history"}} (product_id, changed_at DESC);
+
+INSERT INTO {{qualified "%s_price_history"}} (product_id, price)
+SELECT id, price FROM {{table}};
+
+CREATE OR REPLACE FUNCTION {{qualified "record_%s_price_change"}}() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO {{qualified "%s_price_history"}} (product_id, price) VALUES (NEW.id, NEW.price);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER {{name "%s_price_history_insert"}}
+  AFTER INSERT ON {{table}}
+  FOR EACH ROW EXECUTE FUNCTION {{qualified "record_%s_price_change"}}();
+
+CREATE TRIGGER {{name "%s_price_history_update"}}
+  AFTER UPDATE OF price ON {{table}}
+  FOR EACH ROW WHEN (OLD.price IS DISTINCT FROM NEW.price)
+  EXECUTE FUNCTION {{qualified "record_%s_price_change"}}();
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS {{name "%s_record_deletion"}} ON {{table}};
+DROP FUNCTION IF EXISTS {{qualified "record_%s_deletion"}}();
+DROP TABLE IF EXISTS {{qualified "%s_deletions"}};
+DROP TRIGGER IF EXISTS {{name "%s_set_updated_at"}} ON {{table}};
+DROP FUNCTION IF EXISTS {{qualified "set_%s_updated_at"}}();
+ALTER TABLE {{table}} DROP COLUMN IF EXISTS updated_at;
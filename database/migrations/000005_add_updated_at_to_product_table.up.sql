@@ -0,0 +1,31 @@
+ALTER TABLE {{table}} ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX {{name "%s_updated_at_idx"}} ON {{table}} (updated_at);
+
+CREATE OR REPLACE FUNCTION {{qualified "set_%s_updated_at"}}() RETURNS TRIGGER AS $$
+BEGIN
+  NEW.updated_at = NOW();
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER {{name "%s_set_updated_at"}}
+  BEFORE UPDATE ON {{table}}
+  FOR EACH ROW EXECUTE FUNCTION {{qualified "set_%s_updated_at"}}();
+
+CREATE TABLE {{qualified "%s_deletions"}} (
+  id BOOLEAN PRIMARY KEY NOT NULL DEFAULT TRUE CHECK (id),
+  deleted_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE OR REPLACE FUNCTION {{qualified "record_%s_deletion"}}() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO {{qualified "%s_deletions"}} (deleted_at) VALUES (NOW())
+  ON CONFLICT (id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER {{name "%s_record_deletion"}}
+  AFTER DELETE ON {{table}}
+  FOR EACH STATEMENT EXECUTE FUNCTION {{qualified "record_%s_deletion"}}();
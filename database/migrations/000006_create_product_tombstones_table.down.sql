@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS {{name "%s_record_tombstone"}} ON {{table}};
+DROP FUNCTION IF EXISTS {{qualified "record_%s_tombstone"}}();
+DROP TABLE IF EXISTS {{qualified "%s_tombstones"}};
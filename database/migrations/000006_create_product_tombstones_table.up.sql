@@ -0,0 +1,18 @@
+CREATE TABLE {{qualified "%s_tombstones"}} (
+  id SERIAL PRIMARY KEY NOT NULL,
+  product_id INTEGER NOT NULL,
+  deleted_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX {{name "%s_tombstones_deleted_at_idx"}} ON {{qualified "%s_tombstones"}} (deleted_at);
+
+CREATE OR REPLACE FUNCTION {{qualified "record_%s_tombstone"}}() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO {{qualified "%s_tombstones"}} (product_id) VALUES (OLD.id);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER {{name "%s_record_tombstone"}}
+  AFTER DELETE ON {{table}}
+  FOR EACH ROW EXECUTE FUNCTION {{qualified "record_%s_tombstone"}}();
@@ -0,0 +1,19 @@
+CREATE TABLE {{qualified "%s_deletions"}} (
+  id BOOLEAN PRIMARY KEY NOT NULL DEFAULT TRUE CHECK (id),
+  deleted_at TIMESTAMPTZ NOT NULL
+);
+
+INSERT INTO {{qualified "%s_deletions"}} (deleted_at)
+SELECT MAX(deleted_at) FROM {{qualified "%s_tombstones"}} HAVING MAX(deleted_at) IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION {{qualified "record_%s_deletion"}}() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO {{qualified "%s_deletions"}} (deleted_at) VALUES (NOW())
+  ON CONFLICT (id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER {{name "%s_record_deletion"}}
+  AFTER DELETE ON {{table}}
+  FOR EACH STATEMENT EXECUTE FUNCTION {{qualified "record_%s_deletion"}}();
@@ -0,0 +1,4 @@
+ALTER TABLE {{qualified "%s_price_schedules"}}
+  DROP CONSTRAINT {{name "%s_price_schedules_product_id_fkey"}},
+  ADD CONSTRAINT {{name "%s_price_schedules_product_id_fkey"}}
+    FOREIGN KEY (product_id) REFERENCES {{table}} (id) ON DELETE CASCADE;
@@ -0,0 +1,8 @@
+-- A pending price schedule keeps its product from being deleted, so DELETE
+-- answers 409 instead of silently dropping the schedule. The price history
+-- keeps cascading: every product has history from the insert trigger, so
+-- restricting it would make every product undeletable.
+ALTER TABLE {{qualified "%s_price_schedules"}}
+  DROP CONSTRAINT {{name "%s_price_schedules_product_id_fkey"}},
+  ADD CONSTRAINT {{name "%s_price_schedules_product_id_fkey"}}
+    FOREIGN KEY (product_id) REFERENCES {{table}} (id) ON DELETE RESTRICT;
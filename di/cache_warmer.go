@@ -0,0 +1,10 @@
+package di
+
+import (
+	"github.com/gabriwl165/clean-arch-go/adapter/worker/cachewarmer"
+	"github.com/gabriwl165/clean-arch-go/core/domain"
+)
+
+func ConfigCacheWarmerDI(productUseCase domain.ProductUseCase) *cachewarmer.Worker {
+	return cachewarmer.New(productUseCase)
+}
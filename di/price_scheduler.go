@@ -0,0 +1,16 @@
+package di
+
+import (
+	"time"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
+	"github.com/gabriwl165/clean-arch-go/adapter/postgres/productrepository"
+	"github.com/gabriwl165/clean-arch-go/adapter/worker/pricescheduler"
+	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+)
+
+func ConfigPriceSchedulerDI(conn postgres.PoolInterface, interval time.Duration) *pricescheduler.Worker {
+	productRepository := productrepository.New(conn, productRepositoryOptions())
+	productUseCase := productusecase.New(productRepository, productUseCaseOptions())
+	return pricescheduler.New(productUseCase, interval)
+}
@@ -1,16 +1,96 @@
 package di
 
 import (
+	"sync"
+
+	"github.com/gabriwl165/clean-arch-go/adapter/cache"
+	"github.com/gabriwl165/clean-arch-go/adapter/cache/productcache"
+	"github.com/gabriwl165/clean-arch-go/adapter/coalesce/productcoalesce"
 	"github.com/gabriwl165/clean-arch-go/adapter/http/productservice"
 	"github.com/gabriwl165/clean-arch-go/adapter/postgres"
 	"github.com/gabriwl165/clean-arch-go/adapter/postgres/productrepository"
+	"github.com/gabriwl165/clean-arch-go/adapter/retry"
+	"github.com/gabriwl165/clean-arch-go/adapter/retry/productretry"
 	"github.com/gabriwl165/clean-arch-go/core/domain"
+	"github.com/gabriwl165/clean-arch-go/core/dto"
 	"github.com/gabriwl165/clean-arch-go/core/usecase/productusecase"
+	"github.com/jackc/pgx/v4"
+	"github.com/spf13/viper"
 )
 
-func ConfigProductDI(conn postgres.PoolInterface) domain.ProductService {
-	productRepository := productrepository.New(conn)
-	productUseCase := productusecase.New(productRepository)
-	ProductService := productservice.New(productUseCase)
+func ConfigProductUseCaseDI(conn postgres.PoolInterface) domain.ProductUseCase {
+	productRepository := productrepository.New(conn, productRepositoryOptions())
+	if viper.GetInt("retry.maxAttempts") > 1 {
+		productRepository = productretry.New(productRepository, retry.Policy{
+			MaxAttempts: viper.GetInt("retry.maxAttempts"),
+			BaseDelay:   viper.GetDuration("retry.baseDelay"),
+			MaxDelay:    viper.GetDuration("retry.maxDelay"),
+			Retryable:   postgres.IsTransient,
+		})
+	}
+	if viper.GetBool("cache.coalesceFetch") {
+		productRepository = productcoalesce.New(productRepository)
+	}
+	if viper.GetBool("cache.staleOnError") {
+		productRepository = productcache.New(productRepository, cache.NewMemory(viper.GetInt("cache.maxEntries")))
+	}
+	return productusecase.New(productRepository, productUseCaseOptions())
+}
+
+// ConfigProductExplainerDI builds the explainer behind GET /admin/explain
+// straight on the repository, so plans are never served from a cache.
+func ConfigProductExplainerDI(conn postgres.PoolInterface) productrepository.Explainer {
+	return productrepository.NewExplainer(conn, productRepositoryOptions())
+}
+
+func ConfigProductDI(productUseCase domain.ProductUseCase) domain.ProductService {
+	ProductService := productservice.New(productUseCase, productservice.Options{
+		StrictDecoding: viper.GetBool("server.strictDecoding"),
+		JSONLimits: dto.JSONLimits{
+			MaxDepth:       viper.GetInt("server.maxJSONDepth"),
+			MaxArrayLength: viper.GetInt("server.maxJSONArrayLength"),
+		},
+		Validation: productUseCaseOptions().Validation,
+	})
 	return ProductService
 }
+
+func productUseCaseOptions() productusecase.Options {
+	return productusecase.Options{
+		SearchMinLength: viper.GetInt("pagination.searchMinLength"),
+		SearchPolicy:    viper.GetString("pagination.searchMinLengthPolicy"),
+		Validation: dto.ValidationRules{
+			MinPrice:             float32(viper.GetFloat64("validation.minPrice")),
+			MaxNameLength:        viper.GetInt("validation.maxNameLength"),
+			MaxDescriptionLength: viper.GetInt("validation.maxDescriptionLength"),
+			RequireDescription:   viper.GetBool("validation.requireDescription"),
+		},
+		MaxBatchSize: viper.GetInt("server.maxBatchSize"),
+	}
+}
+
+func productRepositoryOptions() productrepository.Options {
+	return productrepository.Options{
+		CountStrategy: viper.GetString("pagination.countStrategy"),
+		CountCap:      viper.GetInt32("pagination.countCap"),
+		MaxOffset:     viper.GetInt("pagination.maxOffset"),
+		DefaultSort:   viper.GetString("pagination.defaultSort"),
+		Schema:        viper.GetString("db.schema"),
+		Table:         viper.GetString("db.productTable"),
+		TotalCache:    productTotalCache(),
+		TotalCacheTTL: viper.GetDuration("pagination.totalCacheTTL"),
+	}
+}
+
+// productTotalCache is shared by every product repository so writes made by
+// one, such as the price scheduler, invalidate totals cached by the others.
+var productTotalCache = sync.OnceValue(func() cache.Store {
+	if viper.GetDuration("pagination.totalCacheTTL") <= 0 {
+		return nil
+	}
+	return cache.NewMemory(viper.GetInt("cache.maxEntries"))
+})
+
+func ProductTable() pgx.Identifier {
+	return postgres.TableIdentifier(viper.GetString("db.schema"), viper.GetString("db.productTable"))
+}